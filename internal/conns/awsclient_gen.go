@@ -9,6 +9,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
 	"github.com/aws/aws-sdk-go-v2/service/route53domains"
 	"github.com/aws/aws-sdk-go-v2/service/transcribe"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/accessanalyzer"
 	"github.com/aws/aws-sdk-go/service/account"
@@ -311,18 +312,19 @@ import (
 )
 
 type AWSClient struct {
-	AccountID                 string
-	DefaultTagsConfig         *tftags.DefaultConfig
-	DNSSuffix                 string
-	IgnoreTagsConfig          *tftags.IgnoreConfig
-	MediaConvertAccountConn   *mediaconvert.MediaConvert
-	Partition                 string
-	Region                    string
-	ReverseDNSPrefix          string
-	S3ConnURICleaningDisabled *s3.S3
-	Session                   *session.Session
-	SupportedPlatforms        []string
-	TerraformVersion          string
+	AccountID                                 string
+	DefaultTagsConfig                         *tftags.DefaultConfig
+	DNSSuffix                                 string
+	IgnoreTagsConfig                          *tftags.IgnoreConfig
+	MediaConvertAccountConn                   *mediaconvert.MediaConvert
+	Partition                                 string
+	Region                                    string
+	ReverseDNSPrefix                          string
+	S3ConnURICleaningDisabled                 *s3.S3
+	Session                                   *session.Session
+	SupportedPlatforms                        []string
+	TerraformVersion                          string
+	VPCPeeringConnectionStandaloneOptionsOnly bool
 
 	ACMConn                          *acm.ACM
 	ACMPCAConn                       *acmpca.ACMPCA
@@ -641,3 +643,19 @@ func (client *AWSClient) PartitionHostname(prefix string) string {
 func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.Region, client.DNSSuffix)
 }
+
+// EC2ConnForRegion returns an EC2 client for the specified region using the provider's credentials and configuration.
+// The provider's EC2 client is returned if the region is empty or the provider's region.
+func (client *AWSClient) EC2ConnForRegion(region string) (*ec2.EC2, error) {
+	if region == "" || region == aws.StringValue(client.EC2Conn.Config.Region) {
+		return client.EC2Conn, nil
+	}
+
+	sess, err := NewSessionForRegion(&client.EC2Conn.Config, region, client.TerraformVersion)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ec2.New(sess), nil
+}
@@ -2,6 +2,11 @@ package conns
 
 import (
 	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -77,3 +82,56 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 		})
 	}
 }
+
+func TestAWSClientEC2ConnForRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	conn := ec2.New(session.Must(session.NewSession(&aws.Config{
+		Region: aws.String(endpoints.UsWest2RegionID),
+	})))
+	client := &AWSClient{
+		EC2Conn: conn,
+		Region:  endpoints.UsWest2RegionID,
+	}
+
+	testCases := []struct {
+		Name           string
+		Region         string
+		ExpectedRegion string
+		ExpectSame     bool
+	}{
+		{
+			Name:           "empty region",
+			Region:         "",
+			ExpectedRegion: endpoints.UsWest2RegionID,
+			ExpectSame:     true,
+		},
+		{
+			Name:           "provider region",
+			Region:         endpoints.UsWest2RegionID,
+			ExpectedRegion: endpoints.UsWest2RegionID,
+			ExpectSame:     true,
+		},
+		{
+			Name:           "other region",
+			Region:         endpoints.UsEast1RegionID,
+			ExpectedRegion: endpoints.UsEast1RegionID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := client.EC2ConnForRegion(testCase.Region)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if (got == conn) != testCase.ExpectSame {
+				t.Errorf("got provider's EC2 client: %t, expected %t", got == conn, testCase.ExpectSame)
+			}
+
+			if got, want := aws.StringValue(got.Config.Region), testCase.ExpectedRegion; got != want {
+				t.Errorf("got region %s, expected %s", got, want)
+			}
+		})
+	}
+}
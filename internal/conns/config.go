@@ -48,37 +48,38 @@ import (
 )
 
 type Config struct {
-	AccessKey                      string
-	AllowedAccountIds              []string
-	AssumeRole                     *awsbase.AssumeRole
-	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
-	CustomCABundle                 string
-	DefaultTagsConfig              *tftags.DefaultConfig
-	EC2MetadataServiceEnableState  imds.ClientEnableState
-	EC2MetadataServiceEndpoint     string
-	EC2MetadataServiceEndpointMode string
-	Endpoints                      map[string]string
-	ForbiddenAccountIds            []string
-	HTTPProxy                      string
-	IgnoreTagsConfig               *tftags.IgnoreConfig
-	Insecure                       bool
-	MaxRetries                     int
-	Profile                        string
-	Region                         string
-	S3UsePathStyle                 bool
-	SecretKey                      string
-	SharedConfigFiles              []string
-	SharedCredentialsFiles         []string
-	SkipCredsValidation            bool
-	SkipGetEC2Platforms            bool
-	SkipRegionValidation           bool
-	SkipRequestingAccountId        bool
-	STSRegion                      string
-	SuppressDebugLog               bool
-	TerraformVersion               string
-	Token                          string
-	UseDualStackEndpoint           bool
-	UseFIPSEndpoint                bool
+	AccessKey                                 string
+	AllowedAccountIds                         []string
+	AssumeRole                                *awsbase.AssumeRole
+	AssumeRoleWithWebIdentity                 *awsbase.AssumeRoleWithWebIdentity
+	CustomCABundle                            string
+	DefaultTagsConfig                         *tftags.DefaultConfig
+	EC2MetadataServiceEnableState             imds.ClientEnableState
+	EC2MetadataServiceEndpoint                string
+	EC2MetadataServiceEndpointMode            string
+	Endpoints                                 map[string]string
+	ForbiddenAccountIds                       []string
+	HTTPProxy                                 string
+	IgnoreTagsConfig                          *tftags.IgnoreConfig
+	Insecure                                  bool
+	MaxRetries                                int
+	Profile                                   string
+	Region                                    string
+	S3UsePathStyle                            bool
+	SecretKey                                 string
+	SharedConfigFiles                         []string
+	SharedCredentialsFiles                    []string
+	SkipCredsValidation                       bool
+	SkipGetEC2Platforms                       bool
+	SkipRegionValidation                      bool
+	SkipRequestingAccountId                   bool
+	STSRegion                                 string
+	SuppressDebugLog                          bool
+	TerraformVersion                          string
+	Token                                     string
+	UseDualStackEndpoint                      bool
+	UseFIPSEndpoint                           bool
+	VPCPeeringConnectionStandaloneOptionsOnly bool
 }
 
 // Client configures and returns a fully initialized AWSClient
@@ -193,6 +194,7 @@ func (c *Config) Client(ctx context.Context) (interface{}, diag.Diagnostics) {
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
 	client.Session = sess
 	client.TerraformVersion = c.TerraformVersion
+	client.VPCPeeringConnectionStandaloneOptionsOnly = c.VPCPeeringConnectionStandaloneOptionsOnly
 
 	client.FISConn = fis.NewFromConfig(cfg, func(o *fis.Options) {
 		if endpoint := c.Endpoints[names.FIS]; endpoint != "" {
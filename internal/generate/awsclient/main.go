@@ -131,23 +131,25 @@ import (
 {{ range .Services }}
 	"github.com/aws/aws-sdk-go{{ if eq .SDKVersion "2" }}-v2{{ end }}/service/{{ .GoPackage }}"
 {{- end }}
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 type AWSClient struct {
-	AccountID                 string
-	DefaultTagsConfig         *tftags.DefaultConfig
-	DNSSuffix                 string
-	IgnoreTagsConfig          *tftags.IgnoreConfig
-	MediaConvertAccountConn   *mediaconvert.MediaConvert
-	Partition                 string
-	Region                    string
-	ReverseDNSPrefix          string
-	S3ConnURICleaningDisabled *s3.S3
-	Session                   *session.Session
-	SupportedPlatforms        []string
-	TerraformVersion          string
+	AccountID                                 string
+	DefaultTagsConfig                         *tftags.DefaultConfig
+	DNSSuffix                                 string
+	IgnoreTagsConfig                          *tftags.IgnoreConfig
+	MediaConvertAccountConn                   *mediaconvert.MediaConvert
+	Partition                                 string
+	Region                                    string
+	ReverseDNSPrefix                          string
+	S3ConnURICleaningDisabled                 *s3.S3
+	Session                                   *session.Session
+	SupportedPlatforms                        []string
+	TerraformVersion                          string
+	VPCPeeringConnectionStandaloneOptionsOnly bool
 
 	{{ range .Services }}
 	{{ .ProviderNameUpper }}Conn *{{ .GoPackage }}.{{ .ClientTypeName }}
@@ -167,4 +169,20 @@ func (client *AWSClient) PartitionHostname(prefix string) string {
 func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.Region, client.DNSSuffix)
 }
+
+// EC2ConnForRegion returns an EC2 client for the specified region using the provider's credentials and configuration.
+// The provider's EC2 client is returned if the region is empty or the provider's region.
+func (client *AWSClient) EC2ConnForRegion(region string) (*ec2.EC2, error) {
+	if region == "" || region == aws.StringValue(client.EC2Conn.Config.Region) {
+		return client.EC2Conn, nil
+	}
+
+	sess, err := NewSessionForRegion(&client.EC2Conn.Config, region, client.TerraformVersion)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ec2.New(sess), nil
+}
 `
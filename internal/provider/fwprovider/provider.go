@@ -157,6 +157,12 @@ func (p *provider) GetSchema(ctx context.Context) (tfsdk.Schema, diag.Diagnostic
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"vpc_peering_connection_standalone_options_only": {
+				Type:     types.BoolType,
+				Optional: true,
+				Description: "Make the accepter and requester options of VPC Peering Connection resources read-only, " +
+					"so that they can only be modified using aws_vpc_peering_connection_options. This is experimental.",
+			},
 		},
 		Blocks: map[string]tfsdk.Block{
 			"assume_role": {
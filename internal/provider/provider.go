@@ -410,6 +410,12 @@ func Provider() *schema.Provider {
 				Optional:    true,
 				Description: "Resolve an endpoint with FIPS capability",
 			},
+			"vpc_peering_connection_standalone_options_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Make the accepter and requester options of VPC Peering Connection resources read-only, " +
+					"so that they can only be modified using aws_vpc_peering_connection_options. This is experimental.",
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -588,6 +594,8 @@ func Provider() *schema.Provider {
 			"aws_vpc_ipam_pool":                              ec2.DataSourceIPAMPool(),
 			"aws_vpc_ipam_preview_next_cidr":                 ec2.DataSourceIPAMPreviewNextCIDR(),
 			"aws_vpc_peering_connection":                     ec2.DataSourceVPCPeeringConnection(),
+			"aws_vpc_peering_connection_dry_run":             ec2.DataSourceVPCPeeringConnectionDryRun(),
+			"aws_vpc_peering_connection_routes":              ec2.DataSourceVPCPeeringConnectionRoutes(),
 			"aws_vpc_peering_connections":                    ec2.DataSourceVPCPeeringConnections(),
 			"aws_vpc":                                        ec2.DataSourceVPC(),
 			"aws_vpcs":                                       ec2.DataSourceVPCs(),
@@ -1388,6 +1396,8 @@ func Provider() *schema.Provider {
 			"aws_vpc_peering_connection":                           ec2.ResourceVPCPeeringConnection(),
 			"aws_vpc_peering_connection_accepter":                  ec2.ResourceVPCPeeringConnectionAccepter(),
 			"aws_vpc_peering_connection_options":                   ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_vpc_peering_connection_route":                     ec2.ResourceVPCPeeringConnectionRoute(),
+			"aws_vpc_peering_connection_routes":                    ec2.ResourceVPCPeeringConnectionRoutes(),
 			"aws_vpn_connection":                                   ec2.ResourceVPNConnection(),
 			"aws_vpn_connection_route":                             ec2.ResourceVPNConnectionRoute(),
 			"aws_vpn_gateway":                                      ec2.ResourceVPNGateway(),
@@ -2147,6 +2157,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVer
 		Token:                          d.Get("token").(string),
 		UseDualStackEndpoint:           d.Get("use_dualstack_endpoint").(bool),
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
+		VPCPeeringConnectionStandaloneOptionsOnly: d.Get("vpc_peering_connection_standalone_options_only").(bool),
 	}
 
 	if v, ok := d.GetOk("max_retries"); ok {
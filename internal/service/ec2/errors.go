@@ -2,6 +2,8 @@ package ec2
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -14,6 +16,7 @@ const (
 	errCodeClientInvalidHostIDNotFound                    = "Client.InvalidHostID.NotFound"
 	ErrCodeDefaultSubnetAlreadyExistsInAvailabilityZone   = "DefaultSubnetAlreadyExistsInAvailabilityZone"
 	errCodeDependencyViolation                            = "DependencyViolation"
+	errCodeDryRunOperation                                = "DryRunOperation"
 	errCodeGatewayNotAttached                             = "Gateway.NotAttached"
 	errCodeIncorrectState                                 = "IncorrectState"
 	errCodeInvalidAMIIDNotFound                           = "InvalidAMIID.NotFound"
@@ -70,6 +73,7 @@ const (
 	errCodeInvalidSpotFleetRequestConfig                  = "InvalidSpotFleetRequestConfig"
 	errCodeInvalidSpotFleetRequestIdNotFound              = "InvalidSpotFleetRequestId.NotFound"
 	errCodeInvalidSpotInstanceRequestIDNotFound           = "InvalidSpotInstanceRequestID.NotFound"
+	errCodeInvalidStateTransition                         = "InvalidStateTransition"
 	errCodeInvalidSubnetCIDRReservationIDNotFound         = "InvalidSubnetCidrReservationID.NotFound"
 	errCodeInvalidSubnetIDNotFound                        = "InvalidSubnetID.NotFound"
 	errCodeInvalidSubnetIdNotFound                        = "InvalidSubnetId.NotFound"
@@ -88,6 +92,7 @@ const (
 	errCodeInvalidVPNGatewayAttachmentNotFound            = "InvalidVpnGatewayAttachment.NotFound"
 	errCodeInvalidVPNGatewayIDNotFound                    = "InvalidVpnGatewayID.NotFound"
 	errCodeNatGatewayNotFound                             = "NatGatewayNotFound"
+	errCodeOperationNotPermitted                          = "OperationNotPermitted"
 	errCodeResourceNotReady                               = "ResourceNotReady"
 	errCodeSnapshotCreationPerVolumeRateExceeded          = "SnapshotCreationPerVolumeRateExceeded"
 	errCodeUnsupportedOperation                           = "UnsupportedOperation"
@@ -159,3 +164,45 @@ func UnsuccessfulItemsError(apiObjects []*ec2.UnsuccessfulItem) error {
 
 	return errors.ErrorOrNil()
 }
+
+// VPCPeeringConnectionFailedError is returned when an EC2 VPC Peering Connection enters the failed state,
+// e.g. because the requester and accepter VPCs have overlapping CIDR blocks, or is deleted while waiting
+// for it to become active.
+type VPCPeeringConnectionFailedError struct {
+	ID         string
+	StatusCode string
+	Message    string
+	// OverlappingCIDRBlocks describes the requester's and accepter's CIDR blocks that overlap, if any.
+	OverlappingCIDRBlocks []string
+	// PeerAccountInvalid is whether the failure indicates that the peer account is invalid, suspended or closed.
+	PeerAccountInvalid bool
+	PeerOwnerID        string
+}
+
+func (e *VPCPeeringConnectionFailedError) Error() string {
+	if len(e.OverlappingCIDRBlocks) > 0 {
+		return fmt.Sprintf("EC2 VPC Peering Connection (%s) %s: %s (overlapping CIDR blocks: %s)", e.ID, e.StatusCode, e.Message, strings.Join(e.OverlappingCIDRBlocks, ", "))
+	}
+
+	if e.PeerAccountInvalid {
+		peerAccount := "the peer account"
+		if e.PeerOwnerID != "" {
+			peerAccount = fmt.Sprintf("the peer account (%s)", e.PeerOwnerID)
+		}
+
+		return fmt.Sprintf("EC2 VPC Peering Connection (%s) %s: %s. Verify that `peer_vpc_id` and `peer_owner_id` are correct, and that %s is active, not suspended or closed", e.ID, e.StatusCode, e.Message, peerAccount)
+	}
+
+	return fmt.Sprintf("EC2 VPC Peering Connection (%s) %s: %s", e.ID, e.StatusCode, e.Message)
+}
+
+// vpcPeeringConnectionFailedPeerAccountMessageRegexp matches the status messages of VPC Peering Connections that
+// failed because the peer account is closed, inactive or suspended. AWS's generic failure message,
+// "Failed due to incorrect VPC-ID, Account ID, or overlapping CIDR range", isn't matched as it doesn't identify the cause.
+var vpcPeeringConnectionFailedPeerAccountMessageRegexp = regexp.MustCompile(`(?i)account.*\b(closed|inactive|suspended)\b|\b(closed|inactive|suspended)\b.*account`)
+
+// vpcPeeringConnectionFailedPeerAccount returns whether the specified status message of a failed VPC Peering Connection
+// indicates that the peer account is invalid, suspended or closed.
+func vpcPeeringConnectionFailedPeerAccount(message string) bool {
+	return vpcPeeringConnectionFailedPeerAccountMessageRegexp.MatchString(message)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -2839,8 +2840,8 @@ func FindVPCEndpointSubnetAssociationExists(conn *ec2.EC2, vpcEndpointID string,
 	}
 }
 
-func FindVPCPeeringConnection(conn *ec2.EC2, input *ec2.DescribeVpcPeeringConnectionsInput) (*ec2.VpcPeeringConnection, error) {
-	output, err := FindVPCPeeringConnections(conn, input)
+func FindVPCPeeringConnection(ctx context.Context, conn ec2iface.EC2API, input *ec2.DescribeVpcPeeringConnectionsInput) (*ec2.VpcPeeringConnection, error) {
+	output, err := FindVPCPeeringConnections(ctx, conn, input)
 
 	if err != nil {
 		return nil, err
@@ -2857,10 +2858,11 @@ func FindVPCPeeringConnection(conn *ec2.EC2, input *ec2.DescribeVpcPeeringConnec
 	return output[0], nil
 }
 
-func FindVPCPeeringConnections(conn *ec2.EC2, input *ec2.DescribeVpcPeeringConnectionsInput) ([]*ec2.VpcPeeringConnection, error) {
+func FindVPCPeeringConnections(ctx context.Context, conn ec2iface.EC2API, input *ec2.DescribeVpcPeeringConnectionsInput) ([]*ec2.VpcPeeringConnection, error) {
 	var output []*ec2.VpcPeeringConnection
 
-	err := conn.DescribeVpcPeeringConnectionsPages(input, func(page *ec2.DescribeVpcPeeringConnectionsOutput, lastPage bool) bool {
+	// Throttling is retried by the AWS SDK, up to the provider's max_retries.
+	err := conn.DescribeVpcPeeringConnectionsPagesWithContext(ctx, input, func(page *ec2.DescribeVpcPeeringConnectionsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -2888,12 +2890,12 @@ func FindVPCPeeringConnections(conn *ec2.EC2, input *ec2.DescribeVpcPeeringConne
 	return output, nil
 }
 
-func FindVPCPeeringConnectionByID(conn *ec2.EC2, id string) (*ec2.VpcPeeringConnection, error) {
+func FindVPCPeeringConnectionByID(ctx context.Context, conn ec2iface.EC2API, id string) (*ec2.VpcPeeringConnection, error) {
 	input := &ec2.DescribeVpcPeeringConnectionsInput{
 		VpcPeeringConnectionIds: aws.StringSlice([]string{id}),
 	}
 
-	output, err := FindVPCPeeringConnection(conn, input)
+	output, err := FindVPCPeeringConnection(ctx, conn, input)
 
 	if err != nil {
 		return nil, err
@@ -2921,6 +2923,74 @@ func FindVPCPeeringConnectionByID(conn *ec2.EC2, id string) (*ec2.VpcPeeringConn
 	return output, nil
 }
 
+// FindVPCPeeringConnectionByIDAndStatus returns the VPC Peering Connection with the specified ID
+// only if it is in the specified status.
+// VPC Peering Connections in a terminal status are never found, as with FindVPCPeeringConnectionByID.
+func FindVPCPeeringConnectionByIDAndStatus(ctx context.Context, conn ec2iface.EC2API, id, status string) (*ec2.VpcPeeringConnection, error) {
+	output, err := FindVPCPeeringConnectionByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode := aws.StringValue(output.Status.Code); statusCode != status {
+		return nil, &resource.NotFoundError{
+			Message: fmt.Sprintf("EC2 VPC Peering Connection (%s) status is %s, expected %s", id, statusCode, status),
+		}
+	}
+
+	return output, nil
+}
+
+// findPendingVPCPeeringConnectionByCreateInput returns the VPC Peering Connection not yet accepted that a
+// CreateVpcPeeringConnection request with the specified input would have created.
+func findPendingVPCPeeringConnectionByCreateInput(ctx context.Context, conn ec2iface.EC2API, input *ec2.CreateVpcPeeringConnectionInput) (*ec2.VpcPeeringConnection, error) {
+	describeInput := &ec2.DescribeVpcPeeringConnectionsInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"accepter-vpc-info.owner-id": aws.StringValue(input.PeerOwnerId),
+			"accepter-vpc-info.vpc-id":   aws.StringValue(input.PeerVpcId),
+			"requester-vpc-info.vpc-id":  aws.StringValue(input.VpcId),
+		}),
+	}
+
+	describeInput.Filters = append(describeInput.Filters, NewFilter("status-code", []string{
+		ec2.VpcPeeringConnectionStateReasonCodeInitiatingRequest,
+		ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance,
+		ec2.VpcPeeringConnectionStateReasonCodeProvisioning,
+	}))
+
+	for _, v := range input.TagSpecifications {
+		describeInput.Filters = append(describeInput.Filters, BuildTagFilterList(v.Tags)...)
+	}
+
+	output, err := FindVPCPeeringConnections(ctx, conn, describeInput)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var vpcPeeringConnections []*ec2.VpcPeeringConnection
+
+	for _, v := range output {
+		// The accepter's region can't be filtered on.
+		if region := aws.StringValue(input.PeerRegion); region != "" && (v.AccepterVpcInfo == nil || aws.StringValue(v.AccepterVpcInfo.Region) != region) {
+			continue
+		}
+
+		vpcPeeringConnections = append(vpcPeeringConnections, v)
+	}
+
+	if len(vpcPeeringConnections) == 0 {
+		return nil, tfresource.NewEmptyResultError(describeInput)
+	}
+
+	if count := len(vpcPeeringConnections); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, describeInput)
+	}
+
+	return vpcPeeringConnections[0], nil
+}
+
 // FindVPNGatewayRoutePropagationExists returns NotFoundError if no route propagation for the specified VPN gateway is found.
 func FindVPNGatewayRoutePropagationExists(conn *ec2.EC2, routeTableID, gatewayID string) error {
 	routeTable, err := FindRouteTableByID(conn, routeTableID)
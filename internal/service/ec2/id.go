@@ -61,6 +61,25 @@ func VPCEndpointSubnetAssociationCreateID(vpcEndpointID, subnetID string) string
 	return fmt.Sprintf("a-%s%d", vpcEndpointID, create.StringHashcode(subnetID))
 }
 
+const vpcPeeringConnectionRoutesIDSeparator = "_"
+
+func VPCPeeringConnectionRoutesCreateID(vpcPeeringConnectionID, requesterRouteTableID, accepterRouteTableID string) string {
+	parts := []string{vpcPeeringConnectionID, requesterRouteTableID, accepterRouteTableID}
+	id := strings.Join(parts, vpcPeeringConnectionRoutesIDSeparator)
+
+	return id
+}
+
+func VPCPeeringConnectionRoutesParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, vpcPeeringConnectionRoutesIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected vpc-peering-connection-id%[2]srequester-route-table-id%[2]saccepter-route-table-id", id, vpcPeeringConnectionRoutesIDSeparator)
+}
+
 func VPNGatewayVPCAttachmentCreateID(vpnGatewayID, vpcID string) string {
 	return fmt.Sprintf("vpn-attachment-%x", create.StringHashcode(fmt.Sprintf("%s-%s", vpcID, vpnGatewayID)))
 }
@@ -1,11 +1,13 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -892,10 +894,10 @@ func StatusVPCIPv6CIDRBlockAssociationState(conn *ec2.EC2, id string) resource.S
 	}
 }
 
-func StatusVPCPeeringConnectionActive(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+func StatusVPCPeeringConnectionActive(ctx context.Context, conn ec2iface.EC2API, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		// Don't call FindVPCPeeringConnectionByID as it maps useful status codes to NotFoundError.
-		output, err := FindVPCPeeringConnection(conn, &ec2.DescribeVpcPeeringConnectionsInput{
+		output, err := FindVPCPeeringConnection(ctx, conn, &ec2.DescribeVpcPeeringConnectionsInput{
 			VpcPeeringConnectionIds: aws.StringSlice([]string{id}),
 		})
 
@@ -907,13 +909,48 @@ func StatusVPCPeeringConnectionActive(conn *ec2.EC2, id string) resource.StateRe
 			return nil, "", err
 		}
 
-		return output, aws.StringValue(output.Status.Code), nil
+		statusCode := aws.StringValue(output.Status.Code)
+
+		switch statusCode {
+		case ec2.VpcPeeringConnectionStateReasonCodeFailed:
+			err := &VPCPeeringConnectionFailedError{
+				ID:                    id,
+				StatusCode:            statusCode,
+				Message:               aws.StringValue(output.Status.Message),
+				OverlappingCIDRBlocks: vpcPeeringConnectionOverlappingCIDRBlocks(ctx, conn, output),
+			}
+
+			// Without overlapping CIDR blocks, a failure citing the account most likely means the peer account is invalid, suspended or closed.
+			if len(err.OverlappingCIDRBlocks) == 0 && vpcPeeringConnectionFailedPeerAccount(err.Message) {
+				err.PeerAccountInvalid = true
+
+				if v := output.AccepterVpcInfo; v != nil {
+					err.PeerOwnerID = aws.StringValue(v.OwnerId)
+				}
+			}
+
+			return output, statusCode, err
+		// The VPC Peering Connection is being deleted concurrently, e.g. by the peer account. It will never become active.
+		case ec2.VpcPeeringConnectionStateReasonCodeDeleting, ec2.VpcPeeringConnectionStateReasonCodeDeleted:
+			message := "connection is being deleted"
+			if v := aws.StringValue(output.Status.Message); v != "" {
+				message = fmt.Sprintf("%s (%s)", message, v)
+			}
+
+			return output, statusCode, &VPCPeeringConnectionFailedError{
+				ID:         id,
+				StatusCode: statusCode,
+				Message:    message,
+			}
+		}
+
+		return output, statusCode, nil
 	}
 }
 
-func StatusVPCPeeringConnectionDeleted(conn *ec2.EC2, id string) resource.StateRefreshFunc {
+func StatusVPCPeeringConnectionDeleted(ctx context.Context, conn ec2iface.EC2API, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := FindVPCPeeringConnectionByID(conn, id)
+		output, err := FindVPCPeeringConnectionByID(ctx, conn, id)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
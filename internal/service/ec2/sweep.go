@@ -4,6 +4,7 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -2138,25 +2139,12 @@ func sweepVPCPeeringConnections(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-	input := &ec2.DescribeVpcPeeringConnectionsInput{}
 	conn := client.(*conns.AWSClient).EC2Conn
+	accountID := client.(*conns.AWSClient).AccountID
+	input := &ec2.DescribeVpcPeeringConnectionsInput{}
 	sweepResources := make([]*sweep.SweepResource, 0)
 
-	err = conn.DescribeVpcPeeringConnectionsPages(input, func(page *ec2.DescribeVpcPeeringConnectionsOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
-		}
-
-		for _, v := range page.VpcPeeringConnections {
-			r := ResourceVPCPeeringConnection()
-			d := r.Data(nil)
-			d.SetId(aws.StringValue(v.VpcPeeringConnectionId))
-
-			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
-		}
-
-		return !lastPage
-	})
+	vpcPeeringConnections, err := FindVPCPeeringConnections(context.Background(), conn, input)
 
 	if sweep.SkipSweepError(err) {
 		log.Printf("[WARN] Skipping EC2 VPC Peering Connection sweep for %s: %s", region, err)
@@ -2167,6 +2155,34 @@ func sweepVPCPeeringConnections(region string) error {
 		return fmt.Errorf("error listing EC2 VPC Peering Connections (%s): %w", region, err)
 	}
 
+	for _, v := range vpcPeeringConnections {
+		id := aws.StringValue(v.VpcPeeringConnectionId)
+
+		// Skip VPC Peering Connections in a terminal state.
+		if v.Status != nil {
+			switch statusCode := aws.StringValue(v.Status.Code); statusCode {
+			case ec2.VpcPeeringConnectionStateReasonCodeDeleted,
+				ec2.VpcPeeringConnectionStateReasonCodeExpired,
+				ec2.VpcPeeringConnectionStateReasonCodeFailed,
+				ec2.VpcPeeringConnectionStateReasonCodeRejected:
+				log.Printf("[INFO] Skipping EC2 VPC Peering Connection %s: %s", id, statusCode)
+				continue
+			}
+		}
+
+		// Skip VPC Peering Connections requested by another account.
+		if v.RequesterVpcInfo != nil && aws.StringValue(v.RequesterVpcInfo.OwnerId) != accountID {
+			log.Printf("[INFO] Skipping EC2 VPC Peering Connection %s: requested by account %s", id, aws.StringValue(v.RequesterVpcInfo.OwnerId))
+			continue
+		}
+
+		r := ResourceVPCPeeringConnection()
+		d := r.Data(nil)
+		d.SetId(id)
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+	}
+
 	err = sweep.SweepOrchestrator(sweepResources)
 
 	if err != nil {
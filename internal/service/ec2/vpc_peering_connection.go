@@ -1,36 +1,72 @@
 package ec2
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
+const (
+	vpcPeeringConnectionOrientationAccepter  = "accepter"
+	vpcPeeringConnectionOrientationRequester = "requester"
+)
+
+// vpcPeeringConnectionOptionsManagedByInline is the peering_options_managed_by value recorded when
+// options were last modified using a resource's inline accepter and requester configuration blocks.
+const vpcPeeringConnectionOptionsManagedByInline = "inline"
+
 func ResourceVPCPeeringConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceVPCPeeringConnectionCreate,
-		Read:   resourceVPCPeeringConnectionRead,
-		Update: resourceVPCPeeringConnectionUpdate,
-		Delete: resourceVPCPeeringConnectionDelete,
+		CreateWithoutTimeout: resourceVPCPeeringConnectionCreate,
+		ReadWithoutTimeout:   resourceVPCPeeringConnectionRead,
+		UpdateWithoutTimeout: resourceVPCPeeringConnectionUpdate,
+		DeleteWithoutTimeout: resourceVPCPeeringConnectionDelete,
+
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceVPCPeeringConnectionImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(1 * time.Minute),
+			Create: schema.DefaultTimeout(3 * time.Minute),
 			Update: schema.DefaultTimeout(1 * time.Minute),
-			Delete: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(3 * time.Minute),
+		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceVPCPeeringConnectionV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: VPCPeeringConnectionStateUpgradeV0,
+				Version: 0,
+			},
 		},
 
 		// Keep in sync with aws_vpc_peering_connection_accepter's schema.
@@ -40,53 +76,197 @@ func ResourceVPCPeeringConnection() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"accept_status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"accepter": vpcPeeringConnectionOptionsSchema,
+			"accepter_assume_role": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"external_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(2, 1224),
+						},
+						"role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"session_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"accepter_cidr_block": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"accepter_cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"accepter_ipv6_cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"accepter_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"accepter_tags": tftags.TagsSchema(),
+			"allow_remote_vpc_dns_resolution": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"requester"},
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"auto_accept": {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
-			"peer_owner_id": {
+			"description_json": {
 				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
 				Computed: true,
 			},
-			"peer_region": {
+			"dns_resolution_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"expiration_time": {
 				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"is_cross_account": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_cross_region": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"manage_peering_options": {
+				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
+				Default:  true,
+			},
+			"orientation": {
+				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"peer_owner_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"peer_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
 			"peer_vpc_id": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
+			},
+			"peering_options_managed_by": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"requester": vpcPeeringConnectionOptionsSchema,
-			"tags":      tftags.TagsSchema(),
-			"tags_all":  tftags.TagsSchemaComputed(),
+			"requester_cidr_block": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"requester_cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"requester_ipv6_cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"requester_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"retain_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"skip_destroy_wait": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"skip_options_wait": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
+			},
+			"wait_for_accepter": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"vpc_peering_connection_id": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			vpcPeeringConnectionStandaloneOptionsOnlyCustomizeDiff,
+			resourceVPCPeeringConnectionCustomizeDiff,
+			// Swapping vpc_id and peer_vpc_id only changes the orientation of the VPC Peering Connection.
+			customdiff.ForceNewIf("peer_owner_id", vpcPeeringConnectionForceNewUnlessOrientationChanged("peer_owner_id")),
+			customdiff.ForceNewIf("peer_vpc_id", vpcPeeringConnectionForceNewUnlessOrientationChanged("peer_vpc_id")),
+			customdiff.ForceNewIf("vpc_id", vpcPeeringConnectionForceNewUnlessOrientationChanged("vpc_id")),
+			verify.SetTagsDiff,
+		),
 	}
 }
 
 var vpcPeeringConnectionOptionsSchema = &schema.Schema{
-	Type:     schema.TypeList,
-	Optional: true,
-	Computed: true,
-	MaxItems: 1,
+	Type:             schema.TypeList,
+	Optional:         true,
+	Computed:         true,
+	MaxItems:         1,
+	DiffSuppressFunc: vpcPeeringConnectionOptionsDiffSuppress,
 	Elem: &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"allow_classic_link_to_remote_vpc": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
+				Type:       schema.TypeBool,
+				Optional:   true,
+				Default:    false,
+				Deprecated: `With the retirement of EC2-Classic the allow_classic_link_to_remote_vpc attribute has been deprecated and will be removed in a future version.`,
 			},
 			"allow_remote_vpc_dns_resolution": {
 				Type:     schema.TypeBool,
@@ -94,15 +274,16 @@ var vpcPeeringConnectionOptionsSchema = &schema.Schema{
 				Default:  false,
 			},
 			"allow_vpc_to_remote_classic_link": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
+				Type:       schema.TypeBool,
+				Optional:   true,
+				Default:    false,
+				Deprecated: `With the retirement of EC2-Classic the allow_vpc_to_remote_classic_link attribute has been deprecated and will be removed in a future version.`,
 			},
 		},
 	},
 }
 
-func resourceVPCPeeringConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceVPCPeeringConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
@@ -114,53 +295,99 @@ func resourceVPCPeeringConnectionCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	if v, ok := d.GetOk("peer_owner_id"); ok {
+		if d.Get("auto_accept").(bool) && v.(string) != meta.(*conns.AWSClient).AccountID && !vpcPeeringConnectionHasAccepterAssumeRole(d) {
+			return diag.FromErr(vpcPeeringConnectionCrossAccountAutoAcceptError(v.(string)))
+		}
+
 		input.PeerOwnerId = aws.String(v.(string))
 	}
 
+	timeout := d.Timeout(schema.TimeoutCreate)
+
 	if v, ok := d.GetOk("peer_region"); ok {
-		if _, ok := d.GetOk("auto_accept"); ok {
-			return fmt.Errorf("`peer_region` cannot be set whilst `auto_accept` is `true` when creating an EC2 VPC Peering Connection")
+		input.PeerRegion = aws.String(v.(string))
+
+		// A cross-region request can be rejected if the peer owner ID is omitted, even within a single account.
+		if input.PeerOwnerId == nil {
+			input.PeerOwnerId = aws.String(meta.(*conns.AWSClient).AccountID)
 		}
 
-		input.PeerRegion = aws.String(v.(string))
+		// Cross-region peering connections can take several minutes to leave the provisioning state.
+		if v.(string) != meta.(*conns.AWSClient).Region && timeout < VPCPeeringConnectionCrossRegionCreateTimeout {
+			timeout = VPCPeeringConnectionCrossRegionCreateTimeout
+		}
 	}
 
-	log.Printf("[DEBUG] Creating EC2 VPC Peering Connection: %s", input)
-	output, err := conn.CreateVpcPeeringConnection(input)
+	output, err := createVPCPeeringConnection(ctx, conn, input, d.Timeout(schema.TimeoutCreate))
 
 	if err != nil {
-		return fmt.Errorf("error creating EC2 VPC Peering Connection: %w", err)
+		return diag.Errorf("error creating EC2 VPC Peering Connection: %s", err)
 	}
 
-	d.SetId(aws.StringValue(output.VpcPeeringConnection.VpcPeeringConnectionId))
+	d.SetId(aws.StringValue(output.VpcPeeringConnectionId))
 
-	vpcPeeringConnection, err := WaitVPCPeeringConnectionActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+	vpcPeeringConnection, err := WaitVPCPeeringConnectionActive(ctx, conn, d.Id(), timeout)
 
 	if err != nil {
-		return fmt.Errorf("error waiting for EC2 VPC Peering Connection (%s) create: %w", d.Id(), err)
+		return diag.Errorf("error waiting for EC2 VPC Peering Connection (%s) create: %s", d.Id(), err)
 	}
 
-	if _, ok := d.GetOk("auto_accept"); ok && aws.StringValue(vpcPeeringConnection.Status.Code) == ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance {
-		vpcPeeringConnection, err = acceptVPCPeeringConnection(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+	accepterConn, err := vpcPeeringConnectionAccepterConn(d, meta, vpcPeeringConnection)
+
+	if err != nil {
+		return diag.Errorf("error creating EC2 VPC Peering Connection (%s) accepter client: %s", d.Id(), err)
+	}
+
+	if (d.Get("auto_accept").(bool) || vpcPeeringConnectionAcceptsImplicitly(d, meta, vpcPeeringConnection)) && aws.StringValue(vpcPeeringConnection.Status.Code) == ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance {
+		vpcPeeringConnection, err = acceptVPCPeeringConnection(ctx, accepterConn, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("wait_for_accepter").(bool) && aws.StringValue(vpcPeeringConnection.Status.Code) == ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance {
+		vpcPeeringConnection, err = WaitVPCPeeringConnectionAccepted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+
+		if err != nil {
+			return diag.Errorf("error waiting for EC2 VPC Peering Connection (%s) to be accepted: %s", d.Id(), err)
 		}
 	}
 
-	if err := modifyVPCPeeringConnectionOptions(conn, d, vpcPeeringConnection, true); err != nil {
-		return err
+	// A cross-account VPC Peering Connection that's still pending acceptance can only have its options modified
+	// once it has been accepted in the accepter's account. The configured options are then applied by a later apply.
+	if aws.StringValue(vpcPeeringConnection.Status.Code) == ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance &&
+		aws.StringValue(vpcPeeringConnection.AccepterVpcInfo.OwnerId) != aws.StringValue(vpcPeeringConnection.RequesterVpcInfo.OwnerId) {
+		log.Printf("[WARN] EC2 VPC Peering Connection (%s) is pending acceptance by %s, not modifying options", d.Id(), aws.StringValue(vpcPeeringConnection.AccepterVpcInfo.OwnerId))
+	} else if vpcPeeringConnectionManagesInlineOptions(d, meta) {
+		modified, err := modifyVPCPeeringConnectionOptions(ctx, conn, accepterConn, d, vpcPeeringConnection, true)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if modified {
+			d.Set("peering_options_managed_by", vpcPeeringConnectionOptionsManagedByInline)
+		}
 	}
 
-	return resourceVPCPeeringConnectionRead(d, meta)
+	if v, ok := d.GetOk("accepter_tags"); ok && len(v.(map[string]interface{})) > 0 {
+		accepterTags := defaultTagsConfig.MergeTags(tftags.New(v.(map[string]interface{})))
+
+		if err := updateVPCPeeringConnectionAccepterTags(ctx, accepterConn, d.Id(), nil, accepterTags, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceVPCPeeringConnectionRead(ctx, d, meta)
 }
 
-func resourceVPCPeeringConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceVPCPeeringConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	vpcPeeringConnection, err := FindVPCPeeringConnectionByID(conn, d.Id())
+	vpcPeeringConnection, err := FindVPCPeeringConnectionByID(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] EC2 VPC Peering Connection %s not found, removing from state", d.Id())
@@ -169,13 +396,52 @@ func resourceVPCPeeringConnectionRead(d *schema.ResourceData, meta interface{})
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading EC2 VPC Peering Connection (%s): %w", d.Id(), err)
+		return diag.Errorf("error reading EC2 VPC Peering Connection (%s): %s", d.Id(), err)
 	}
 
 	d.Set("accept_status", vpcPeeringConnection.Status.Code)
+	d.Set("accept_status_message", vpcPeeringConnection.Status.Message)
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   ec2.ServiceName,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("vpc-peering-connection/%s", d.Id()),
+	}.String()
+	d.Set("arn", arn)
+
+	descriptionJSON, err := json.Marshal(vpcPeeringConnection)
+
+	if err != nil {
+		return diag.Errorf("error marshalling EC2 VPC Peering Connection (%s) description to JSON: %s", d.Id(), err)
+	}
+
+	d.Set("description_json", string(descriptionJSON))
+	d.Set("dns_resolution_enabled", vpcPeeringConnectionDNSResolutionEnabled(vpcPeeringConnection))
+	if v := vpcPeeringConnection.ExpirationTime; v != nil && aws.StringValue(vpcPeeringConnection.Status.Code) == ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance {
+		d.Set("expiration_time", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("expiration_time", nil)
+	}
+	d.Set("is_cross_account", aws.StringValue(vpcPeeringConnection.AccepterVpcInfo.OwnerId) != aws.StringValue(vpcPeeringConnection.RequesterVpcInfo.OwnerId))
+	d.Set("is_cross_region", aws.StringValue(vpcPeeringConnection.AccepterVpcInfo.Region) != aws.StringValue(vpcPeeringConnection.RequesterVpcInfo.Region))
 	d.Set("peer_region", vpcPeeringConnection.AccepterVpcInfo.Region)
+	d.Set("vpc_peering_connection_id", vpcPeeringConnection.VpcPeeringConnectionId)
+
+	// An orientation set on import is retained.
+	orientation := d.Get("orientation").(string)
+
+	if orientation == "" {
+		if accountID := meta.(*conns.AWSClient).AccountID; accountID == aws.StringValue(vpcPeeringConnection.AccepterVpcInfo.OwnerId) && accountID != aws.StringValue(vpcPeeringConnection.RequesterVpcInfo.OwnerId) {
+			orientation = vpcPeeringConnectionOrientationAccepter
+		} else {
+			orientation = vpcPeeringConnectionOrientationRequester
+		}
+	}
+
+	d.Set("orientation", orientation)
 
-	if accountID := meta.(*conns.AWSClient).AccountID; accountID == aws.StringValue(vpcPeeringConnection.AccepterVpcInfo.OwnerId) && accountID != aws.StringValue(vpcPeeringConnection.RequesterVpcInfo.OwnerId) {
+	if orientation == vpcPeeringConnectionOrientationAccepter {
 		// We're the accepter.
 		d.Set("peer_owner_id", vpcPeeringConnection.RequesterVpcInfo.OwnerId)
 		d.Set("peer_vpc_id", vpcPeeringConnection.RequesterVpcInfo.VpcId)
@@ -187,9 +453,32 @@ func resourceVPCPeeringConnectionRead(d *schema.ResourceData, meta interface{})
 		d.Set("vpc_id", vpcPeeringConnection.RequesterVpcInfo.VpcId)
 	}
 
+	// The accepter's VPC information may not be populated until the connection is active.
+	if v := vpcPeeringConnection.AccepterVpcInfo; v != nil {
+		d.Set("accepter_cidr_block", v.CidrBlock)
+		d.Set("accepter_region", v.Region)
+		if err := d.Set("accepter_cidr_blocks", flattenVPCPeeringConnectionCIDRBlocks(v.CidrBlockSet)); err != nil {
+			return diag.Errorf("error setting accepter_cidr_blocks: %s", err)
+		}
+		if err := d.Set("accepter_ipv6_cidr_blocks", flattenVPCPeeringConnectionIPv6CIDRBlocks(v.Ipv6CidrBlockSet)); err != nil {
+			return diag.Errorf("error setting accepter_ipv6_cidr_blocks: %s", err)
+		}
+	}
+
+	if v := vpcPeeringConnection.RequesterVpcInfo; v != nil {
+		d.Set("requester_cidr_block", v.CidrBlock)
+		d.Set("requester_region", v.Region)
+		if err := d.Set("requester_cidr_blocks", flattenVPCPeeringConnectionCIDRBlocks(v.CidrBlockSet)); err != nil {
+			return diag.Errorf("error setting requester_cidr_blocks: %s", err)
+		}
+		if err := d.Set("requester_ipv6_cidr_blocks", flattenVPCPeeringConnectionIPv6CIDRBlocks(v.Ipv6CidrBlockSet)); err != nil {
+			return diag.Errorf("error setting requester_ipv6_cidr_blocks: %s", err)
+		}
+	}
+
 	if vpcPeeringConnection.AccepterVpcInfo.PeeringOptions != nil {
 		if err := d.Set("accepter", []interface{}{flattenVPCPeeringConnectionOptionsDescription(vpcPeeringConnection.AccepterVpcInfo.PeeringOptions)}); err != nil {
-			return fmt.Errorf("error setting accepter: %w", err)
+			return diag.Errorf("error setting accepter: %s", err)
 		}
 	} else {
 		d.Set("accepter", nil)
@@ -197,7 +486,7 @@ func resourceVPCPeeringConnectionRead(d *schema.ResourceData, meta interface{})
 
 	if vpcPeeringConnection.RequesterVpcInfo.PeeringOptions != nil {
 		if err := d.Set("requester", []interface{}{flattenVPCPeeringConnectionOptionsDescription(vpcPeeringConnection.RequesterVpcInfo.PeeringOptions)}); err != nil {
-			return fmt.Errorf("error setting requester: %w", err)
+			return diag.Errorf("error setting requester: %s", err)
 		}
 	} else {
 		d.Set("requester", nil)
@@ -207,90 +496,516 @@ func resourceVPCPeeringConnectionRead(d *schema.ResourceData, meta interface{})
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+		return diag.Errorf("error setting tags: %s", err)
 	}
 
 	if err := d.Set("tags_all", tags.Map()); err != nil {
-		return fmt.Errorf("error setting tags_all: %w", err)
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	// The accepter's tags are only read if configured, as reading them requires access to the accepter's side.
+	if _, ok := d.GetOk("accepter_tags"); ok {
+		accepterConn, err := vpcPeeringConnectionAccepterConn(d, meta, vpcPeeringConnection)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		accepterVPCPeeringConnection, err := FindVPCPeeringConnectionByID(ctx, accepterConn, d.Id())
+
+		if err != nil {
+			return diag.Errorf("error reading EC2 VPC Peering Connection (%s) accepter tags: %s", d.Id(), err)
+		}
+
+		accepterTags := KeyValueTags(accepterVPCPeeringConnection.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+		if err := d.Set("accepter_tags", accepterTags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+			return diag.Errorf("error setting accepter_tags: %s", err)
+		}
 	}
 
 	return nil
 }
 
-func resourceVPCPeeringConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceVPCPeeringConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
-	vpcPeeringConnection, err := FindVPCPeeringConnectionByID(conn, d.Id())
+	vpcPeeringConnection, err := FindVPCPeeringConnectionByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error reading EC2 VPC Peering Connection (%s): %s", d.Id(), err)
+	}
+
+	// This function is shared with aws_vpc_peering_connection_accepter, so the provider
+	// may be configured for either side of a cross-region VPC Peering Connection.
+	accepterConn, err := vpcPeeringConnectionAccepterConn(d, meta, vpcPeeringConnection)
 
 	if err != nil {
-		return fmt.Errorf("error reading EC2 VPC Peering Connection (%s): %w", d.Id(), err)
+		return diag.FromErr(err)
+	}
+
+	// A VPC Peering Connection that is still being provisioned can't yet be accepted or have its options modified.
+	if d.Get("auto_accept").(bool) {
+		switch aws.StringValue(vpcPeeringConnection.Status.Code) {
+		case ec2.VpcPeeringConnectionStateReasonCodeInitiatingRequest, ec2.VpcPeeringConnectionStateReasonCodeProvisioning:
+			vpcPeeringConnection, err = WaitVPCPeeringConnectionActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+
+			if err != nil {
+				return diag.Errorf("error waiting for EC2 VPC Peering Connection (%s) to become available: %s", d.Id(), err)
+			}
+		}
 	}
 
-	if _, ok := d.GetOk("auto_accept"); ok && aws.StringValue(vpcPeeringConnection.Status.Code) == ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance {
-		vpcPeeringConnection, err = acceptVPCPeeringConnection(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+	if d.Get("auto_accept").(bool) && aws.StringValue(vpcPeeringConnection.Status.Code) == ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance {
+		if peerOwnerID := aws.StringValue(vpcPeeringConnection.AccepterVpcInfo.OwnerId); peerOwnerID != meta.(*conns.AWSClient).AccountID && !vpcPeeringConnectionHasAccepterAssumeRole(d) {
+			return diag.FromErr(vpcPeeringConnectionCrossAccountAutoAcceptError(peerOwnerID))
+		}
+
+		vpcPeeringConnection, err = acceptVPCPeeringConnection(ctx, accepterConn, d.Id(), d.Timeout(schema.TimeoutUpdate))
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	if d.HasChanges("accepter", "requester") {
-		if err := modifyVPCPeeringConnectionOptions(conn, d, vpcPeeringConnection, true); err != nil {
-			return err
+	// aws_vpc_peering_connection_accepter, which shares this function, has no wait_for_accepter argument.
+	if v, ok := d.Get("wait_for_accepter").(bool); ok && v && aws.StringValue(vpcPeeringConnection.Status.Code) == ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance {
+		vpcPeeringConnection, err = WaitVPCPeeringConnectionAccepted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return diag.Errorf("error waiting for EC2 VPC Peering Connection (%s) to be accepted: %s", d.Id(), err)
+		}
+	}
+
+	if vpcPeeringConnectionManagesInlineOptions(d, meta) {
+		requesterConn, err := vpcPeeringConnectionRequesterConn(meta, vpcPeeringConnection)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		modified, err := modifyVPCPeeringConnectionOptions(ctx, requesterConn, accepterConn, d, vpcPeeringConnection, true)
+
+		if err != nil {
+			return diag.FromErr(err)
 		}
+
+		if modified {
+			d.Set("peering_options_managed_by", vpcPeeringConnectionOptionsManagedByInline)
+		}
+	} else {
+		// Options are not managed by this resource, e.g. they're managed by aws_vpc_peering_connection_options.
+		d.Set("peering_options_managed_by", "")
 	}
 
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
-		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
-			return fmt.Errorf("error updating EC2 VPC Peering Connection (%s) tags: %w", d.Id(), err)
+		// Tagging can fail transiently while tag policies are evaluated. Throttling is retried by the AWS SDK.
+		_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+			return nil, UpdateTagsWithContext(ctx, conn, d.Id(), o, n)
+		}, errCodeInvalidParameterException)
+
+		if err != nil {
+			return diag.Errorf("error updating EC2 VPC Peering Connection (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("accepter_tags") {
+		defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+		o, n := d.GetChange("accepter_tags")
+
+		if err := updateVPCPeeringConnectionAccepterTags(ctx, accepterConn, d.Id(), defaultTagsConfig.MergeTags(tftags.New(o)), defaultTagsConfig.MergeTags(tftags.New(n)), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
 		}
 	}
 
-	return resourceVPCPeeringConnectionRead(d, meta)
+	return resourceVPCPeeringConnectionRead(ctx, d, meta)
 }
 
-func resourceVPCPeeringConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceVPCPeeringConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("retain_on_destroy").(bool) {
+		log.Printf("[WARN] EC2 VPC Peering Connection (%s) not deleted, removing from state", d.Id())
+
+		return nil
+	}
+
 	conn := meta.(*conns.AWSClient).EC2Conn
 
 	log.Printf("[INFO] Deleting EC2 VPC Peering Connection: %s", d.Id())
-	_, err := conn.DeleteVpcPeeringConnection(&ec2.DeleteVpcPeeringConnectionInput{
-		VpcPeeringConnectionId: aws.String(d.Id()),
-	})
+	_, err := tfresource.RetryWhenContext(ctx, d.Timeout(schema.TimeoutDelete),
+		func() (interface{}, error) {
+			return conn.DeleteVpcPeeringConnectionWithContext(ctx, &ec2.DeleteVpcPeeringConnectionInput{
+				VpcPeeringConnectionId: aws.String(d.Id()),
+			})
+		},
+		func(err error) (bool, error) {
+			// "InvalidStateTransition: Invalid state transition for pcx-0000000000000000, attempted to transition from failed to deleting"
+			// The message varies, e.g. when the VPC Peering Connection has been rejected by the accepter, so only the code is checked.
+			if tfawserr.ErrCodeEquals(err, errCodeInvalidStateTransition) {
+				// A VPC Peering Connection in a terminal state can't be deleted and is treated as already deleted.
+				// Any other state is transitional and the delete is retried.
+				_, findErr := FindVPCPeeringConnectionByID(ctx, conn, d.Id())
+
+				if tfresource.NotFound(findErr) {
+					return false, nil
+				}
+
+				if findErr != nil {
+					return false, findErr
+				}
+
+				return true, err
+			}
+
+			// Dependent resources tracked outside of EC2 can transiently block deletion.
+			if d.Get("force_destroy").(bool) && tfawserr.ErrCodeEquals(err, errCodeDependencyViolation) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
 
 	if tfawserr.ErrCodeEquals(err, errCodeInvalidVPCPeeringConnectionIDNotFound) {
 		return nil
 	}
 
-	// "InvalidStateTransition: Invalid state transition for pcx-0000000000000000, attempted to transition from failed to deleting"
-	if tfawserr.ErrMessageContains(err, "InvalidStateTransition", "to deleting") {
+	if err != nil {
+		return diag.Errorf("error deleting EC2 VPC Peering Connection (%s): %s", d.Id(), err)
+	}
+
+	if d.Get("skip_destroy_wait").(bool) {
 		return nil
 	}
 
-	if err != nil {
-		return fmt.Errorf("error deleting EC2 VPC Peering Connection (%s): %w", d.Id(), err)
+	if _, err := WaitVPCPeeringConnectionDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.Errorf("error waiting for EC2 VPC Peering Connection (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceVPCPeeringConnectionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	const importIDSeparator = ":"
+	parts := strings.Split(d.Id(), importIDSeparator)
+
+	switch len(parts) {
+	case 1:
+	case 2:
+		switch orientation := parts[1]; orientation {
+		case vpcPeeringConnectionOrientationAccepter, vpcPeeringConnectionOrientationRequester:
+			d.SetId(parts[0])
+			d.Set("orientation", orientation)
+		default:
+			return nil, fmt.Errorf("unexpected orientation (%s) in import ID (%s), expected %q or %q", orientation, d.Id(), vpcPeeringConnectionOrientationAccepter, vpcPeeringConnectionOrientationRequester)
+		}
+	default:
+		return nil, fmt.Errorf("unexpected format for import ID (%[1]s), expected VPC-PEERING-CONNECTION-ID or VPC-PEERING-CONNECTION-ID%[2]sORIENTATION", d.Id(), importIDSeparator)
+	}
+
+	d.Set("force_destroy", false)
+	d.Set("manage_peering_options", true)
+	d.Set("retain_on_destroy", false)
+	d.Set("skip_destroy_wait", false)
+	d.Set("skip_options_wait", false)
+	d.Set("wait_for_accepter", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceVPCPeeringConnectionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// A VPC can't be peered with itself in the same account and region.
+	if diff.Id() == "" && diff.NewValueKnown("vpc_id") && diff.NewValueKnown("peer_vpc_id") {
+		if vpcID, peerVPCID := diff.Get("vpc_id").(string), diff.Get("peer_vpc_id").(string); vpcID == peerVPCID {
+			peerOwnerID, peerRegion := diff.Get("peer_owner_id").(string), diff.Get("peer_region").(string)
+
+			if (peerOwnerID == "" || peerOwnerID == meta.(*conns.AWSClient).AccountID) && (peerRegion == "" || peerRegion == meta.(*conns.AWSClient).Region) {
+				return fmt.Errorf("`peer_vpc_id` must not be the same as `vpc_id` (%s) when the peer VPC is in the same account and region", vpcID)
+			}
+		}
+	}
+
+	if vpcPeeringConnectionOrientationChanged(diff) {
+		orientation := vpcPeeringConnectionOrientationAccepter
+
+		if diff.Get("orientation").(string) == vpcPeeringConnectionOrientationAccepter {
+			orientation = vpcPeeringConnectionOrientationRequester
+		}
+
+		if err := diff.SetNew("orientation", orientation); err != nil {
+			return err
+		}
+	}
+
+	// Tag keys with the aws: prefix are reserved for use by AWS and are rejected by CreateTags.
+	for _, key := range []string{"tags", "accepter_tags"} {
+		if !diff.NewValueKnown(key) {
+			continue
+		}
+
+		tags := tftags.New(diff.Get(key).(map[string]interface{}))
+
+		if v := tags.Removed(tags.IgnoreAWS()).Keys(); len(v) > 0 {
+			sort.Strings(v)
+
+			return fmt.Errorf("`%s` must not contain keys with the reserved \"aws:\" prefix: %s", key, strings.Join(v, ", "))
+		}
+	}
+
+	// Tags are scoped to the tagging account and region, so the accepter's tags must be applied to a distinct, reachable accepter side.
+	if v, ok := diff.GetOk("accepter_tags"); ok && len(v.(map[string]interface{})) > 0 {
+		peerOwnerID, peerRegion := diff.Get("peer_owner_id").(string), diff.Get("peer_region").(string)
+		crossAccount := peerOwnerID != "" && peerOwnerID != meta.(*conns.AWSClient).AccountID
+		crossRegion := peerRegion != "" && peerRegion != meta.(*conns.AWSClient).Region
+		v, ok := diff.GetOk("accepter_assume_role")
+		hasAccepterAssumeRole := ok && len(v.([]interface{})) > 0
+
+		if crossAccount && !hasAccepterAssumeRole {
+			return fmt.Errorf("`accepter_tags` requires `accepter_assume_role` for a cross-account EC2 VPC Peering Connection (peer owner ID: %s)", peerOwnerID)
+		}
+
+		if !crossAccount && !crossRegion && !hasAccepterAssumeRole {
+			return fmt.Errorf("`accepter_tags` can only be set for a cross-account or cross-region EC2 VPC Peering Connection. Use `tags` for an EC2 VPC Peering Connection within a single account and region")
+		}
+	}
+
+	// VPC Peering Connections can't span partitions.
+	if v := diff.Get("peer_region").(string); v != "" {
+		if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), v); ok && partition.ID() != meta.(*conns.AWSClient).Partition {
+			return fmt.Errorf("`peer_region` (%s) is in partition %s, which differs from the provider's partition (%s). EC2 VPC Peering Connections cannot span partitions", v, partition.ID(), meta.(*conns.AWSClient).Partition)
+		}
+	}
+
+	// Externally managed options are neither validated nor modified.
+	if !diff.Get("manage_peering_options").(bool) {
+		return clearVPCPeeringConnectionOptionsDiff(diff)
+	}
+
+	// allow_remote_vpc_dns_resolution is shorthand for a requester configuration block with only that option set.
+	if key := "allow_remote_vpc_dns_resolution"; diff.NewValueKnown(key) && (diff.Get(key).(bool) || diff.HasChange(key)) {
+		if vpcPeeringConnectionStandaloneOptionsOnly(meta) {
+			return fmt.Errorf("`%s` is read-only as the provider's `vpc_peering_connection_standalone_options_only` is set. Use the `aws_vpc_peering_connection_options` resource to modify VPC Peering Connection options", key)
+		}
+
+		if err := diff.SetNew("requester", []interface{}{map[string]interface{}{
+			"allow_classic_link_to_remote_vpc": false,
+			"allow_remote_vpc_dns_resolution":  diff.Get(key).(bool),
+			"allow_vpc_to_remote_classic_link": false,
+		}}); err != nil {
+			return err
+		}
+	}
+
+	// Options can only be modified on an active VPC Peering Connection, and a cross-account
+	// VPC Peering Connection remains pending acceptance unless it's auto-accepted using accepter_assume_role.
+	if diff.Id() == "" || diff.Get("accept_status").(string) != ec2.VpcPeeringConnectionStateReasonCodeActive {
+		v, ok := diff.GetOk("accepter_assume_role")
+		acceptedOnCreate := diff.Get("auto_accept").(bool) && ok && len(v.([]interface{})) > 0
+
+		if v := diff.Get("peer_owner_id").(string); v != "" && v != meta.(*conns.AWSClient).AccountID && !acceptedOnCreate {
+			for _, side := range []string{"accepter", "requester"} {
+				for _, option := range []string{"allow_classic_link_to_remote_vpc", "allow_remote_vpc_dns_resolution", "allow_vpc_to_remote_classic_link"} {
+					if key := fmt.Sprintf("%s.0.%s", side, option); diff.Get(key).(bool) {
+						return fmt.Errorf("`%s` must not be `true` for a cross-account EC2 VPC Peering Connection that has not been accepted. "+
+							"Accept the EC2 VPC Peering Connection in the peer account using the `aws_vpc_peering_connection_accepter` resource, "+
+							"then set the options once it is active, or use the `aws_vpc_peering_connection_options` resource", key)
+					}
+				}
+			}
+		}
+	}
+
+	// ClassicLink isn't supported for cross-region VPC Peering Connections.
+	if v := diff.Get("peer_region").(string); v != "" && v != meta.(*conns.AWSClient).Region {
+		for _, side := range []string{"accepter", "requester"} {
+			for _, option := range []string{"allow_classic_link_to_remote_vpc", "allow_vpc_to_remote_classic_link"} {
+				if key := fmt.Sprintf("%s.0.%s", side, option); diff.Get(key).(bool) {
+					return fmt.Errorf("`%s` must not be `true` for a cross-region EC2 VPC Peering Connection", key)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// vpcPeeringConnectionStandaloneOptionsOnly returns whether options can only be modified using aws_vpc_peering_connection_options.
+func vpcPeeringConnectionStandaloneOptionsOnly(meta interface{}) bool {
+	return meta.(*conns.AWSClient).VPCPeeringConnectionStandaloneOptionsOnly
+}
+
+// vpcPeeringConnectionManagesInlineOptions returns whether options are modified using the resource's
+// accepter and requester configuration blocks.
+func vpcPeeringConnectionManagesInlineOptions(d *schema.ResourceData, meta interface{}) bool {
+	return d.Get("manage_peering_options").(bool) && !vpcPeeringConnectionStandaloneOptionsOnly(meta)
+}
+
+// vpcPeeringConnectionStandaloneOptionsOnlyCustomizeDiff rejects changes to inline options, and removes options
+// from the diff, when options can only be modified using aws_vpc_peering_connection_options.
+func vpcPeeringConnectionStandaloneOptionsOnlyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !vpcPeeringConnectionStandaloneOptionsOnly(meta) {
+		return nil
+	}
+
+	for _, key := range []string{"accepter", "requester"} {
+		if diff.NewValueKnown(key) && len(diff.GetChangedKeysPrefix(key)) > 0 {
+			return fmt.Errorf("`%s` is read-only as the provider's `vpc_peering_connection_standalone_options_only` is set. Use the `aws_vpc_peering_connection_options` resource to modify VPC Peering Connection options", key)
+		}
+	}
+
+	return clearVPCPeeringConnectionOptionsDiff(diff)
+}
+
+// vpcPeeringConnectionOrientationChanged returns whether the planned vpc_id and peer_vpc_id
+// are those in state, swapped. The same VPC Peering Connection is then being viewed from the other side.
+func vpcPeeringConnectionOrientationChanged(diff *schema.ResourceDiff) bool {
+	if diff.Id() == "" || !diff.NewValueKnown("vpc_id") || !diff.NewValueKnown("peer_vpc_id") {
+		return false
+	}
+
+	oldVPCID, newVPCID := diff.GetChange("vpc_id")
+	oldPeerVPCID, newPeerVPCID := diff.GetChange("peer_vpc_id")
+
+	return oldVPCID.(string) != newVPCID.(string) && oldVPCID.(string) == newPeerVPCID.(string) && oldPeerVPCID.(string) == newVPCID.(string)
+}
+
+func vpcPeeringConnectionForceNewUnlessOrientationChanged(key string) customdiff.ResourceConditionFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+		return diff.Id() != "" && diff.HasChange(key) && !vpcPeeringConnectionOrientationChanged(diff)
 	}
+}
+
+// vpcPeeringConnectionOptionsDiffSuppress suppresses the difference between an omitted accepter or requester
+// configuration block and one in which every option has its default (false) value.
+func vpcPeeringConnectionOptionsDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if strings.HasSuffix(k, ".#") {
+		if old != "0" || new != "1" {
+			return false
+		}
+
+		for _, v := range d.Get(strings.TrimSuffix(k, ".#")).([]interface{}) {
+			tfMap, ok := v.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			for _, v := range tfMap {
+				if v, ok := v.(bool); ok && v {
+					return false
+				}
+			}
+		}
+
+		return true
+	}
+
+	return old == "" && new == "false"
+}
 
-	if _, err := WaitVPCPeeringConnectionDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
-		return fmt.Errorf("error waiting for EC2 VPC Peering Connection (%s) delete: %s", d.Id(), err)
+// clearVPCPeeringConnectionOptionsDiff removes any accepter or requester options from the diff.
+func clearVPCPeeringConnectionOptionsDiff(diff *schema.ResourceDiff) error {
+	for _, key := range []string{"accepter", "requester"} {
+		if err := diff.Clear(key); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
-func acceptVPCPeeringConnection(conn *ec2.EC2, vpcPeeringConnectionID string, timeout time.Duration) (*ec2.VpcPeeringConnection, error) {
-	log.Printf("[INFO] Accepting EC2 VPC Peering Connection: %s", vpcPeeringConnectionID)
-	_, err := conn.AcceptVpcPeeringConnection(&ec2.AcceptVpcPeeringConnectionInput{
+// createVPCPeeringConnection creates a VPC Peering Connection.
+// CreateVpcPeeringConnection doesn't accept a client token, so the request isn't retried by the AWS SDK.
+// If the outcome of a request is unknown, e.g. its response was lost, the VPC Peering Connection it may have created
+// is looked for before the request is retried.
+func createVPCPeeringConnection(ctx context.Context, conn *ec2.EC2, input *ec2.CreateVpcPeeringConnectionInput, timeout time.Duration) (*ec2.VpcPeeringConnection, error) {
+	var outcomeUnknown bool
+
+	log.Printf("[DEBUG] Creating EC2 VPC Peering Connection: %s", input)
+	outputRaw, err := tfresource.RetryWhenContext(ctx, timeout,
+		func() (interface{}, error) {
+			if outcomeUnknown {
+				output, err := findPendingVPCPeeringConnectionByCreateInput(ctx, conn, input)
+
+				if err == nil {
+					log.Printf("[INFO] Found EC2 VPC Peering Connection (%s) created by a previous request", aws.StringValue(output.VpcPeeringConnectionId))
+
+					return output, nil
+				}
+
+				if !tfresource.NotFound(err) {
+					return nil, err
+				}
+			}
+
+			output, err := conn.CreateVpcPeeringConnectionWithContext(ctx, input, func(r *request.Request) {
+				r.Retryer = client.NoOpRetryer{}
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			return output.VpcPeeringConnection, nil
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrCodeEquals(err, errCodeInvalidVPCIDNotFound) {
+				return true, err
+			}
+
+			if vpcPeeringConnectionCreateOutcomeUnknown(err) {
+				outcomeUnknown = true
+
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.(*ec2.VpcPeeringConnection), nil
+}
+
+// vpcPeeringConnectionCreateOutcomeUnknown reports whether a CreateVpcPeeringConnection request that returned the specified error
+// may nonetheless have created a VPC Peering Connection.
+func vpcPeeringConnectionCreateOutcomeUnknown(err error) bool {
+	var requestFailure awserr.RequestFailure
+
+	// The request reached EC2, but either failed server-side or its response couldn't be read.
+	if errors.As(err, &requestFailure) {
+		return requestFailure.StatusCode() >= http.StatusInternalServerError || requestFailure.Code() == request.ErrCodeSerialization
+	}
+
+	// The request may or may not have reached EC2.
+	return tfawserr.ErrCodeEquals(err, request.ErrCodeRequestError, request.ErrCodeResponseTimeout)
+}
+
+func acceptVPCPeeringConnection(ctx context.Context, conn ec2iface.EC2API, vpcPeeringConnectionID string, timeout time.Duration) (*ec2.VpcPeeringConnection, error) {
+	input := &ec2.AcceptVpcPeeringConnectionInput{
 		VpcPeeringConnectionId: aws.String(vpcPeeringConnectionID),
-	})
+	}
+
+	log.Printf("[INFO] Accepting EC2 VPC Peering Connection: %s", input)
+	// A newly created VPC Peering Connection may not yet be visible to, or acceptable by, the accepter.
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, timeout,
+		func() (interface{}, error) {
+			return conn.AcceptVpcPeeringConnectionWithContext(ctx, input)
+		},
+		errCodeInvalidStateTransition, errCodeInvalidVPCPeeringConnectionIDNotFound,
+	)
 
 	if err != nil {
 		return nil, fmt.Errorf("error acccepting EC2 VPC Peering Connection (%s): %w", vpcPeeringConnectionID, err)
 	}
 
 	// "OperationNotPermitted: Peering pcx-0000000000000000 is not active. Peering options can be added only to active peerings."
-	vpcPeeringConnection, err := WaitVPCPeeringConnectionActive(conn, vpcPeeringConnectionID, timeout)
+	// An accepted VPC Peering Connection may briefly remain pending acceptance, so wait for it to become active.
+	vpcPeeringConnection, err := WaitVPCPeeringConnectionAccepted(ctx, conn, vpcPeeringConnectionID, timeout)
 
 	if err != nil {
 		return nil, fmt.Errorf("error waiting for EC2 VPC Peering Connection (%s) update: %w", vpcPeeringConnectionID, err)
@@ -299,52 +1014,216 @@ func acceptVPCPeeringConnection(conn *ec2.EC2, vpcPeeringConnectionID string, ti
 	return vpcPeeringConnection, nil
 }
 
-func modifyVPCPeeringConnectionOptions(conn *ec2.EC2, d *schema.ResourceData, vpcPeeringConnection *ec2.VpcPeeringConnection, checkActive bool) error {
+// updateVPCPeeringConnectionAccepterTags updates the tags on the accepter's side of the specified VPC Peering Connection.
+func updateVPCPeeringConnectionAccepterTags(ctx context.Context, conn ec2iface.EC2API, vpcPeeringConnectionID string, oldTagsMap, newTagsMap interface{}, timeout time.Duration) error {
+	// The accepter's side of a new VPC Peering Connection may not yet be visible.
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, timeout, func() (interface{}, error) {
+		return nil, UpdateTagsWithContext(ctx, conn, vpcPeeringConnectionID, oldTagsMap, newTagsMap)
+	}, errCodeInvalidParameterException, errCodeInvalidVPCPeeringConnectionIDNotFound)
+
+	if err != nil {
+		return fmt.Errorf("error updating EC2 VPC Peering Connection (%s) accepter tags: %w", vpcPeeringConnectionID, err)
+	}
+
+	return nil
+}
+
+// vpcPeeringConnectionCrossAccountAutoAcceptError returns the error reported when auto_accept is set for a cross-account VPC Peering Connection.
+func vpcPeeringConnectionCrossAccountAutoAcceptError(peerOwnerID string) error {
+	return fmt.Errorf("`auto_accept` cannot be `true` for a cross-account EC2 VPC Peering Connection (peer owner ID: %s). "+
+		"Accept the EC2 VPC Peering Connection in the peer account using the `aws_vpc_peering_connection_accepter` resource.", peerOwnerID)
+}
+
+// vpcPeeringConnectionAccepterConn returns an EC2 client for the accepter's region of the specified VPC Peering Connection.
+// If accepter_assume_role is configured the client uses credentials for the assumed role.
+// Otherwise the provider's EC2 client is returned if the accepter is in the provider's region.
+func vpcPeeringConnectionAccepterConn(d *schema.ResourceData, meta interface{}, vpcPeeringConnection *ec2.VpcPeeringConnection) (*ec2.EC2, error) {
+	region := aws.StringValue(vpcPeeringConnection.AccepterVpcInfo.Region)
+
+	if !vpcPeeringConnectionHasAccepterAssumeRole(d) {
+		return vpcPeeringConnectionConnForRegion(meta, region)
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if region == "" {
+		region = aws.StringValue(conn.Config.Region)
+	}
+
+	sess, err := conns.NewSessionForRegion(&conn.Config, region, meta.(*conns.AWSClient).TerraformVersion)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS session: %w", err)
+	}
+
+	// The session is configured with any EC2 endpoint override, so the role is assumed using the provider's STS endpoint, if any.
+	var stsEndpoint string
+	if v := meta.(*conns.AWSClient).STSConn; v != nil {
+		stsEndpoint = aws.StringValue(v.Config.Endpoint)
+	}
+
+	stsConn := sts.New(sess, &aws.Config{Endpoint: aws.String(stsEndpoint)})
+	tfMap := d.Get("accepter_assume_role").([]interface{})[0].(map[string]interface{})
+	credentials := stscreds.NewCredentialsWithClient(stsConn, tfMap["role_arn"].(string), func(p *stscreds.AssumeRoleProvider) {
+		if v, ok := tfMap["external_id"].(string); ok && v != "" {
+			p.ExternalID = aws.String(v)
+		}
+
+		if v, ok := tfMap["session_name"].(string); ok && v != "" {
+			p.RoleSessionName = v
+		}
+	})
+
+	return ec2.New(sess, &aws.Config{Credentials: credentials}), nil
+}
+
+// vpcPeeringConnectionHasAccepterAssumeRole returns whether accepter_assume_role is configured.
+// aws_vpc_peering_connection_accepter, which shares some CRUD functions, has no such argument.
+func vpcPeeringConnectionHasAccepterAssumeRole(d *schema.ResourceData) bool {
+	v, ok := d.GetOk("accepter_assume_role")
+
+	return ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil
+}
+
+// vpcPeeringConnectionAcceptsImplicitly returns whether a same-account, same-region VPC Peering Connection
+// is accepted on create even though auto_accept isn't set. There's no separate party to accept it,
+// and its configured options can't otherwise be applied.
+func vpcPeeringConnectionAcceptsImplicitly(d *schema.ResourceData, meta interface{}, vpcPeeringConnection *ec2.VpcPeeringConnection) bool {
+	if !vpcPeeringConnectionManagesInlineOptions(d, meta) {
+		return false
+	}
+
+	if aws.StringValue(vpcPeeringConnection.AccepterVpcInfo.OwnerId) != aws.StringValue(vpcPeeringConnection.RequesterVpcInfo.OwnerId) ||
+		aws.StringValue(vpcPeeringConnection.AccepterVpcInfo.Region) != aws.StringValue(vpcPeeringConnection.RequesterVpcInfo.Region) {
+		return false
+	}
+
+	for _, key := range []string{"accepter", "requester"} {
+		if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// vpcPeeringConnectionRequesterConn returns an EC2 client for the requester's region of the specified VPC Peering Connection.
+// The provider's EC2 client is returned if the requester is in the provider's region.
+func vpcPeeringConnectionRequesterConn(meta interface{}, vpcPeeringConnection *ec2.VpcPeeringConnection) (*ec2.EC2, error) {
+	return vpcPeeringConnectionConnForRegion(meta, aws.StringValue(vpcPeeringConnection.RequesterVpcInfo.Region))
+}
+
+func vpcPeeringConnectionConnForRegion(meta interface{}, region string) (*ec2.EC2, error) {
+	conn, err := meta.(*conns.AWSClient).EC2ConnForRegion(region)
+
+	if err != nil {
+		return nil, fmt.Errorf("error creating AWS session: %w", err)
+	}
+
+	return conn, nil
+}
+
+// modifyVPCPeeringConnectionOptions modifies the accepter and requester options of the specified VPC Peering Connection.
+// Requester options are modified using requesterConn, which must be an EC2 client for the requester's region,
+// and accepter options are modified using accepterConn, which must be an EC2 client for the accepter's region.
+// It reports whether any options were modified.
+func modifyVPCPeeringConnectionOptions(ctx context.Context, requesterConn, accepterConn *ec2.EC2, d *schema.ResourceData, vpcPeeringConnection *ec2.VpcPeeringConnection, checkActive bool) (bool, error) {
 	var accepterPeeringConnectionOptions, requesterPeeringConnectionOptions *ec2.PeeringConnectionOptionsRequest
 	crossRegionPeering := aws.StringValue(vpcPeeringConnection.RequesterVpcInfo.Region) != aws.StringValue(vpcPeeringConnection.AccepterVpcInfo.Region)
 
-	if key := "accepter"; d.HasChange(key) {
+	// Options configured while the VPC Peering Connection was pending acceptance are applied once it's active,
+	// even if the configuration hasn't changed since.
+	active := aws.StringValue(vpcPeeringConnection.Status.Code) == ec2.VpcPeeringConnectionStateReasonCodeActive
+
+	if key := "accepter"; d.HasChange(key) || active {
 		if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-			accepterPeeringConnectionOptions = expandPeeringConnectionOptionsRequest(v.([]interface{})[0].(map[string]interface{}), crossRegionPeering)
+			accepterPeeringConnectionOptions = expandPeeringConnectionOptionsRequest(v.([]interface{})[0].(map[string]interface{}), vpcPeeringConnectionVPCInfoOptions(vpcPeeringConnection.AccepterVpcInfo), crossRegionPeering)
+		}
+
+		if !d.HasChange(key) && !vpcPeeringConnectionOptionsDiffer(vpcPeeringConnection.AccepterVpcInfo, accepterPeeringConnectionOptions) {
+			accepterPeeringConnectionOptions = nil
 		}
 	}
 
-	if key := "requester"; d.HasChange(key) {
+	if key := "requester"; d.HasChange(key) || active {
 		if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-			requesterPeeringConnectionOptions = expandPeeringConnectionOptionsRequest(v.([]interface{})[0].(map[string]interface{}), crossRegionPeering)
+			requesterPeeringConnectionOptions = expandPeeringConnectionOptionsRequest(v.([]interface{})[0].(map[string]interface{}), vpcPeeringConnectionVPCInfoOptions(vpcPeeringConnection.RequesterVpcInfo), crossRegionPeering)
+		}
+
+		if !d.HasChange(key) && !vpcPeeringConnectionOptionsDiffer(vpcPeeringConnection.RequesterVpcInfo, requesterPeeringConnectionOptions) {
+			requesterPeeringConnectionOptions = nil
 		}
 	}
 
 	if accepterPeeringConnectionOptions == nil && requesterPeeringConnectionOptions == nil {
-		return nil
+		return false, nil
 	}
 
 	if checkActive {
 		switch statusCode := aws.StringValue(vpcPeeringConnection.Status.Code); statusCode {
 		case ec2.VpcPeeringConnectionStateReasonCodeActive, ec2.VpcPeeringConnectionStateReasonCodeProvisioning:
 		default:
-			return fmt.Errorf(
+			// A cross-account VPC Peering Connection can't be auto-accepted from the requester's account.
+			if accepterOwnerID := aws.StringValue(vpcPeeringConnection.AccepterVpcInfo.OwnerId); accepterOwnerID != aws.StringValue(vpcPeeringConnection.RequesterVpcInfo.OwnerId) {
+				return false, fmt.Errorf(
+					"Unable to modify EC2 VPC Peering Connection Options. EC2 VPC Peering Connection (%s) is not active (current status: %s). "+
+						"A cross-account EC2 VPC Peering Connection must be accepted in the accepter's account (%s). "+
+						"Please use an `aws_vpc_peering_connection_accepter` resource with `auto_accept` set to `true` in that account.",
+					d.Id(), statusCode, accepterOwnerID)
+			}
+
+			return false, fmt.Errorf(
 				"Unable to modify EC2 VPC Peering Connection Options. EC2 VPC Peering Connection (%s) is not active (current status: %s). "+
 					"Please set the `auto_accept` attribute to `true` or activate the EC2 VPC Peering Connection manually.",
 				d.Id(), statusCode)
 		}
 	}
 
-	input := &ec2.ModifyVpcPeeringConnectionOptionsInput{
-		AccepterPeeringConnectionOptions:  accepterPeeringConnectionOptions,
-		RequesterPeeringConnectionOptions: requesterPeeringConnectionOptions,
-		VpcPeeringConnectionId:            aws.String(d.Id()),
+	if accepterConn == requesterConn {
+		input := &ec2.ModifyVpcPeeringConnectionOptionsInput{
+			AccepterPeeringConnectionOptions:  accepterPeeringConnectionOptions,
+			RequesterPeeringConnectionOptions: requesterPeeringConnectionOptions,
+			VpcPeeringConnectionId:            aws.String(d.Id()),
+		}
+
+		if err := modifyVPCPeeringConnectionOptionsWithConn(ctx, requesterConn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return false, err
+		}
+	} else {
+		// The options of each side of a cross-region VPC Peering Connection can only be modified in that side's region.
+		if accepterPeeringConnectionOptions != nil {
+			input := &ec2.ModifyVpcPeeringConnectionOptionsInput{
+				AccepterPeeringConnectionOptions: accepterPeeringConnectionOptions,
+				VpcPeeringConnectionId:           aws.String(d.Id()),
+			}
+
+			if err := modifyVPCPeeringConnectionOptionsWithConn(ctx, accepterConn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return false, err
+			}
+		}
+
+		if requesterPeeringConnectionOptions != nil {
+			input := &ec2.ModifyVpcPeeringConnectionOptionsInput{
+				RequesterPeeringConnectionOptions: requesterPeeringConnectionOptions,
+				VpcPeeringConnectionId:            aws.String(d.Id()),
+			}
+
+			if err := modifyVPCPeeringConnectionOptionsWithConn(ctx, requesterConn, input, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return false, err
+			}
+		}
 	}
 
-	log.Printf("[DEBUG] Modifying VPC Peering Connection Options: %s", input)
-	if _, err := conn.ModifyVpcPeeringConnectionOptions(input); err != nil {
-		return fmt.Errorf("error modifying EC2 VPC Peering Connection (%s) Options: %w", d.Id(), err)
+	if d.Get("skip_options_wait").(bool) {
+		log.Printf("[WARN] Not waiting for EC2 VPC Peering Connection (%s) Options to stabilize", d.Id())
+
+		return true, nil
 	}
 
 	// Retry reading back the modified options to deal with eventual consistency.
 	// Often this is to do with a delay transitioning from pending-acceptance to active.
-	err := resource.Retry(VPCPeeringConnectionOptionsPropagationTimeout, func() *resource.RetryError { // nosemgrep:ci.helper-schema-resource-Retry-without-TimeoutError-check
-		vpcPeeringConnection, err := FindVPCPeeringConnectionByID(conn, d.Id())
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError { // nosemgrep:ci.helper-schema-resource-Retry-without-TimeoutError-check
+		vpcPeeringConnection, err := FindVPCPeeringConnectionByID(ctx, requesterConn, d.Id())
 
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -366,19 +1245,66 @@ func modifyVPCPeeringConnectionOptions(conn *ec2.EC2, d *schema.ResourceData, vp
 	})
 
 	if err != nil {
-		return fmt.Errorf("error waiting for EC2 VPC Peering Connection (%s) Options update: %w", d.Id(), err)
+		return false, fmt.Errorf("error waiting for EC2 VPC Peering Connection (%s) Options update: %w", d.Id(), err)
+	}
+
+	return true, nil
+}
+
+func modifyVPCPeeringConnectionOptionsWithConn(ctx context.Context, conn ec2iface.EC2API, input *ec2.ModifyVpcPeeringConnectionOptionsInput, timeout time.Duration) error {
+	log.Printf("[DEBUG] Modifying VPC Peering Connection Options: %s", input)
+	// The VPC Peering Connection may not yet be visible as active immediately after acceptance.
+	// "OperationNotPermitted: Peering pcx-0000000000000000 is not active. Peering options can be added only to active peerings."
+	_, err := tfresource.RetryWhenAWSErrMessageContainsContext(ctx, timeout, func() (interface{}, error) {
+		return conn.ModifyVpcPeeringConnectionOptionsWithContext(ctx, input)
+	}, errCodeOperationNotPermitted, "is not active")
+
+	if err != nil {
+		return fmt.Errorf("error modifying EC2 VPC Peering Connection (%s) Options: %w", aws.StringValue(input.VpcPeeringConnectionId), err)
 	}
 
 	return nil
 }
 
+// vpcPeeringConnectionDNSResolutionEnabled returns whether DNS resolution from the remote VPC is enabled for both sides
+// of the specified VPC Peering Connection, i.e. whether instances in each VPC can resolve the other VPC's private DNS hostnames.
+func vpcPeeringConnectionDNSResolutionEnabled(vpcPeeringConnection *ec2.VpcPeeringConnection) bool {
+	for _, v := range []*ec2.VpcPeeringConnectionVpcInfo{vpcPeeringConnection.AccepterVpcInfo, vpcPeeringConnection.RequesterVpcInfo} {
+		if v == nil || v.PeeringOptions == nil || !aws.BoolValue(v.PeeringOptions.AllowDnsResolutionFromRemoteVpc) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// vpcPeeringConnectionOptionsDiffer returns whether the requested options differ from a side's current options.
+// vpcPeeringConnectionVPCInfoOptions returns the options of the specified side of a VPC Peering Connection, if known.
+func vpcPeeringConnectionVPCInfoOptions(apiObject *ec2.VpcPeeringConnectionVpcInfo) *ec2.VpcPeeringConnectionOptionsDescription {
+	if apiObject == nil {
+		return nil
+	}
+
+	return apiObject.PeeringOptions
+}
+
+func vpcPeeringConnectionOptionsDiffer(apiObject *ec2.VpcPeeringConnectionVpcInfo, options *ec2.PeeringConnectionOptionsRequest) bool {
+	if apiObject == nil || apiObject.PeeringOptions == nil || options == nil {
+		return false
+	}
+
+	return !vpcPeeringConnectionOptionsEqual(apiObject.PeeringOptions, options)
+}
+
 func vpcPeeringConnectionOptionsEqual(o1 *ec2.VpcPeeringConnectionOptionsDescription, o2 *ec2.PeeringConnectionOptionsRequest) bool {
 	return aws.BoolValue(o1.AllowDnsResolutionFromRemoteVpc) == aws.BoolValue(o2.AllowDnsResolutionFromRemoteVpc) &&
 		aws.BoolValue(o1.AllowEgressFromLocalClassicLinkToRemoteVpc) == aws.BoolValue(o2.AllowEgressFromLocalClassicLinkToRemoteVpc) &&
 		aws.BoolValue(o1.AllowEgressFromLocalVpcToRemoteClassicLink) == aws.BoolValue(o2.AllowEgressFromLocalVpcToRemoteClassicLink)
 }
 
-func expandPeeringConnectionOptionsRequest(tfMap map[string]interface{}, crossRegionPeering bool) *ec2.PeeringConnectionOptionsRequest {
+// expandPeeringConnectionOptionsRequest expands the specified options configuration block.
+// current is the side's options in AWS, if known.
+func expandPeeringConnectionOptionsRequest(tfMap map[string]interface{}, current *ec2.VpcPeeringConnectionOptionsDescription, crossRegionPeering bool) *ec2.PeeringConnectionOptionsRequest {
 	if tfMap == nil {
 		return nil
 	}
@@ -389,12 +1315,18 @@ func expandPeeringConnectionOptionsRequest(tfMap map[string]interface{}, crossRe
 		apiObject.AllowDnsResolutionFromRemoteVpc = aws.Bool(v)
 	}
 
+	// As EC2-Classic has been retired, ClassicLink options are only sent when enabled,
+	// or when being disabled on a legacy account on which they're enabled.
 	if !crossRegionPeering {
-		if v, ok := tfMap["allow_classic_link_to_remote_vpc"].(bool); ok {
+		if current == nil {
+			current = &ec2.VpcPeeringConnectionOptionsDescription{}
+		}
+
+		if v, ok := tfMap["allow_classic_link_to_remote_vpc"].(bool); ok && (v || aws.BoolValue(current.AllowEgressFromLocalClassicLinkToRemoteVpc)) {
 			apiObject.AllowEgressFromLocalClassicLinkToRemoteVpc = aws.Bool(v)
 		}
 
-		if v, ok := tfMap["allow_vpc_to_remote_classic_link"].(bool); ok {
+		if v, ok := tfMap["allow_vpc_to_remote_classic_link"].(bool); ok && (v || aws.BoolValue(current.AllowEgressFromLocalVpcToRemoteClassicLink)) {
 			apiObject.AllowEgressFromLocalVpcToRemoteClassicLink = aws.Bool(v)
 		}
 	}
@@ -423,3 +1355,127 @@ func flattenVPCPeeringConnectionOptionsDescription(apiObject *ec2.VpcPeeringConn
 
 	return tfMap
 }
+
+func flattenVPCPeeringConnectionCIDRBlocks(apiObjects []*ec2.CidrBlock) []string {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, aws.StringValue(apiObject.CidrBlock))
+	}
+
+	return tfList
+}
+
+func flattenVPCPeeringConnectionIPv6CIDRBlocks(apiObjects []*ec2.Ipv6CidrBlock) []string {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []string
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, aws.StringValue(apiObject.Ipv6CidrBlock))
+	}
+
+	return tfList
+}
+
+// vpcPeeringConnectionDescribeVPCTimeout bounds the time spent describing a VPC to report the CIDR blocks
+// of a failed VPC Peering Connection.
+const vpcPeeringConnectionDescribeVPCTimeout = 10 * time.Second
+
+// vpcPeeringConnectionOverlappingCIDRBlocks returns a description of each pair of overlapping requester and
+// accepter IPv4 CIDR blocks of the specified VPC Peering Connection.
+// CIDR blocks missing from the VPC Peering Connection's description are read using DescribeVpcs, which only
+// succeeds for a VPC owned by conn's account in conn's region. Errors are ignored.
+func vpcPeeringConnectionOverlappingCIDRBlocks(ctx context.Context, conn ec2iface.EC2API, vpcPeeringConnection *ec2.VpcPeeringConnection) []string {
+	requesterVPCID, requesterCIDRBlocks := vpcPeeringConnectionVPCCIDRBlocks(ctx, conn, vpcPeeringConnection.RequesterVpcInfo)
+	accepterVPCID, accepterCIDRBlocks := vpcPeeringConnectionVPCCIDRBlocks(ctx, conn, vpcPeeringConnection.AccepterVpcInfo)
+
+	var overlaps []string
+
+	for _, requesterCIDRBlock := range requesterCIDRBlocks {
+		_, requesterIPNet, err := net.ParseCIDR(requesterCIDRBlock)
+
+		if err != nil {
+			continue
+		}
+
+		for _, accepterCIDRBlock := range accepterCIDRBlocks {
+			_, accepterIPNet, err := net.ParseCIDR(accepterCIDRBlock)
+
+			if err != nil {
+				continue
+			}
+
+			if requesterIPNet.Contains(accepterIPNet.IP) || accepterIPNet.Contains(requesterIPNet.IP) {
+				overlaps = append(overlaps, fmt.Sprintf("%s (%s) and %s (%s)", requesterCIDRBlock, requesterVPCID, accepterCIDRBlock, accepterVPCID))
+			}
+		}
+	}
+
+	return overlaps
+}
+
+func vpcPeeringConnectionVPCCIDRBlocks(ctx context.Context, conn ec2iface.EC2API, apiObject *ec2.VpcPeeringConnectionVpcInfo) (string, []string) {
+	if apiObject == nil {
+		return "", nil
+	}
+
+	vpcID := aws.StringValue(apiObject.VpcId)
+
+	var cidrBlocks []string
+
+	for _, v := range apiObject.CidrBlockSet {
+		if v != nil && aws.StringValue(v.CidrBlock) != "" {
+			cidrBlocks = append(cidrBlocks, aws.StringValue(v.CidrBlock))
+		}
+	}
+
+	if len(cidrBlocks) == 0 && aws.StringValue(apiObject.CidrBlock) != "" {
+		cidrBlocks = append(cidrBlocks, aws.StringValue(apiObject.CidrBlock))
+	}
+
+	if len(cidrBlocks) > 0 || vpcID == "" {
+		return vpcID, cidrBlocks
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, vpcPeeringConnectionDescribeVPCTimeout)
+	defer cancel()
+
+	output, err := conn.DescribeVpcsWithContext(ctx, &ec2.DescribeVpcsInput{
+		VpcIds: aws.StringSlice([]string{vpcID}),
+	})
+
+	if err != nil {
+		log.Printf("[DEBUG] Unable to read EC2 VPC (%s) CIDR blocks: %s", vpcID, err)
+
+		return vpcID, nil
+	}
+
+	for _, vpc := range output.Vpcs {
+		for _, v := range vpc.CidrBlockAssociationSet {
+			if v == nil || (v.CidrBlockState != nil && aws.StringValue(v.CidrBlockState.State) != ec2.VpcCidrBlockStateCodeAssociated) {
+				continue
+			}
+
+			if aws.StringValue(v.CidrBlock) != "" {
+				cidrBlocks = append(cidrBlocks, aws.StringValue(v.CidrBlock))
+			}
+		}
+	}
+
+	return vpcID, cidrBlocks
+}
@@ -1,24 +1,29 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceVPCPeeringConnectionAccepter() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceVPCPeeringAccepterCreate,
-		Read:   resourceVPCPeeringConnectionRead,
-		Update: resourceVPCPeeringConnectionUpdate,
-		Delete: resourceVPCPeeringAccepterDelete,
+		CreateWithoutTimeout: resourceVPCPeeringAccepterCreate,
+		ReadWithoutTimeout:   resourceVPCPeeringConnectionRead,
+		UpdateWithoutTimeout: resourceVPCPeeringConnectionUpdate,
+		DeleteWithoutTimeout: resourceVPCPeeringAccepterDelete,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
@@ -26,7 +31,10 @@ func ResourceVPCPeeringConnectionAccepter() *schema.Resource {
 		},
 
 		Importer: &schema.ResourceImporter{
-			State: func(d *schema.ResourceData, m interface{}) (result []*schema.ResourceData, err error) {
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("manage_peering_options", true)
+				d.Set("reject_on_destroy", false)
+				d.Set("skip_options_wait", false)
 				d.Set("vpc_peering_connection_id", d.Id())
 
 				return []*schema.ResourceData{d}, nil
@@ -39,17 +47,73 @@ func ResourceVPCPeeringConnectionAccepter() *schema.Resource {
 		//   - peer_vpc_id is Computed-only
 		//   - vpc_id is Computed-only
 		// and additions:
+		//   - reject_on_destroy Optional
 		//   - vpc_peering_connection_id Required/ForceNew
 		Schema: map[string]*schema.Schema{
 			"accept_status": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"accept_status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"accepter": vpcPeeringConnectionOptionsSchema,
+			"accepter_cidr_block": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"accepter_cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"accepter_ipv6_cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"accepter_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"auto_accept": {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"description_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dns_resolution_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"expiration_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_cross_account": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_cross_region": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"manage_peering_options": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"orientation": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"peer_owner_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -62,9 +126,41 @@ func ResourceVPCPeeringConnectionAccepter() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"peering_options_managed_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"reject_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"requester": vpcPeeringConnectionOptionsSchema,
-			"tags":      tftags.TagsSchema(),
-			"tags_all":  tftags.TagsSchemaComputed(),
+			"requester_cidr_block": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"requester_cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"requester_ipv6_cidr_blocks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"requester_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"skip_options_wait": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -76,47 +172,135 @@ func ResourceVPCPeeringConnectionAccepter() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			vpcPeeringConnectionStandaloneOptionsOnlyCustomizeDiff,
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				// peer_owner_id is the requester's account ID, known once the VPC Peering Connection has been accepted.
+				if diff.Id() != "" && diff.Get("manage_peering_options").(bool) && diff.HasChange("requester") {
+					if v := diff.Get("peer_owner_id").(string); v != "" && v != meta.(*conns.AWSClient).AccountID {
+						return vpcPeeringConnectionAccepterRequesterOptionsError(v)
+					}
+				}
+
+				return nil
+			},
+			customdiff.If(
+				func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+					return !diff.Get("manage_peering_options").(bool)
+				},
+				func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+					return clearVPCPeeringConnectionOptionsDiff(diff)
+				},
+			),
+			verify.SetTagsDiff,
+		),
 	}
 }
 
-func resourceVPCPeeringAccepterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceVPCPeeringAccepterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	vpcPeeringConnectionID := d.Get("vpc_peering_connection_id").(string)
-	vpcPeeringConnection, err := FindVPCPeeringConnectionByID(conn, vpcPeeringConnectionID)
+	vpcPeeringConnection, err := FindVPCPeeringConnectionByID(ctx, conn, vpcPeeringConnectionID)
 
 	if err != nil {
-		return fmt.Errorf("error reading EC2 VPC Peering Connection (%s): %w", vpcPeeringConnectionID, err)
+		return diag.Errorf("error reading EC2 VPC Peering Connection (%s): %s", vpcPeeringConnectionID, err)
+	}
+
+	if vpcPeeringConnectionManagesInlineOptions(d, meta) && d.HasChange("requester") {
+		if v := aws.StringValue(vpcPeeringConnection.RequesterVpcInfo.OwnerId); v != meta.(*conns.AWSClient).AccountID {
+			return diag.FromErr(vpcPeeringConnectionAccepterRequesterOptionsError(v))
+		}
 	}
 
 	d.SetId(vpcPeeringConnectionID)
 
-	if _, ok := d.GetOk("auto_accept"); ok && aws.StringValue(vpcPeeringConnection.Status.Code) == ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance {
-		vpcPeeringConnection, err = acceptVPCPeeringConnection(conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+	// Tags on a VPC Peering Connection are scoped to the tagging account,
+	// so the accepter's tags are applied independently of the requester's.
+	if len(tags) > 0 {
+		if err := CreateTags(conn, d.Id(), tags.Map()); err != nil {
+			return diag.Errorf("error creating EC2 VPC Peering Connection (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	if d.Get("auto_accept").(bool) && aws.StringValue(vpcPeeringConnection.Status.Code) == ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance {
+		vpcPeeringConnection, err = acceptVPCPeeringConnection(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
 
 		if err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 	}
 
-	if err := modifyVPCPeeringConnectionOptions(conn, d, vpcPeeringConnection, true); err != nil {
-		return err
-	}
+	if vpcPeeringConnectionManagesInlineOptions(d, meta) {
+		// The requester's options for a cross-region VPC Peering Connection can only be modified in the requester's region.
+		requesterConn, err := vpcPeeringConnectionRequesterConn(meta, vpcPeeringConnection)
 
-	if len(tags) > 0 {
-		if err := CreateTags(conn, d.Id(), tags.Map()); err != nil {
-			return fmt.Errorf("error creating EC2 VPC Peering Connection (%s) tags: %w", d.Id(), err)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		modified, err := modifyVPCPeeringConnectionOptions(ctx, requesterConn, conn, d, vpcPeeringConnection, true)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if modified {
+			d.Set("peering_options_managed_by", vpcPeeringConnectionOptionsManagedByInline)
 		}
 	}
 
-	return resourceVPCPeeringConnectionRead(d, meta)
+	return resourceVPCPeeringConnectionRead(ctx, d, meta)
 }
 
-func resourceVPCPeeringAccepterDelete(d *schema.ResourceData, meta interface{}) error {
-	log.Printf("[WARN]  EC2 VPC Peering Connection (%s) not deleted, removing from state", d.Id())
+func resourceVPCPeeringAccepterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.Get("reject_on_destroy").(bool) {
+		log.Printf("[WARN] EC2 VPC Peering Connection (%s) not deleted, removing from state", d.Id())
+
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	vpcPeeringConnection, err := FindVPCPeeringConnectionByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading EC2 VPC Peering Connection (%s): %s", d.Id(), err)
+	}
+
+	// Only a VPC Peering Connection that is pending acceptance can be rejected.
+	// An accepted (active) VPC Peering Connection can only be deleted, e.g. by destroying the requester's aws_vpc_peering_connection.
+	if statusCode := aws.StringValue(vpcPeeringConnection.Status.Code); statusCode != ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance {
+		log.Printf("[WARN] EC2 VPC Peering Connection (%s) not rejected as reject_on_destroy only applies to a connection pending acceptance (current status: %s), removing from state", d.Id(), statusCode)
+
+		return nil
+	}
+
+	log.Printf("[INFO] Rejecting EC2 VPC Peering Connection: %s", d.Id())
+	_, err = conn.RejectVpcPeeringConnectionWithContext(ctx, &ec2.RejectVpcPeeringConnectionInput{
+		VpcPeeringConnectionId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVPCPeeringConnectionIDNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error rejecting EC2 VPC Peering Connection (%s): %s", d.Id(), err)
+	}
 
 	return nil
 }
+
+// vpcPeeringConnectionAccepterRequesterOptionsError returns the error reported when the accepter's side of a
+// cross-account VPC Peering Connection is configured with the requester's options.
+func vpcPeeringConnectionAccepterRequesterOptionsError(requesterOwnerID string) error {
+	return fmt.Errorf("`requester` options cannot be modified from the accepter's account of a cross-account EC2 VPC Peering Connection (requester owner ID: %s). "+
+		"Set the `requester` options using the `aws_vpc_peering_connection` or `aws_vpc_peering_connection_options` resource in the requester's account", requesterOwnerID)
+}
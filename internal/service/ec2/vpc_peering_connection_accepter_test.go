@@ -2,6 +2,7 @@ package ec2_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -39,6 +40,8 @@ func TestAccVPCPeeringConnectionAccepter_sameRegionSameAccount(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceNameConnection, "peer_vpc_id", resourceNamePeerVpc, "id"),
 					resource.TestCheckResourceAttrPair(resourceNameConnection, "peer_owner_id", resourceNamePeerVpc, "owner_id"),
 					resource.TestCheckResourceAttr(resourceNameConnection, "peer_region", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceNameConnection, "is_cross_account", "false"),
+					resource.TestCheckResourceAttr(resourceNameConnection, "is_cross_region", "false"),
 					// The aws_vpc_peering_connection_accepter documentation says:
 					//	vpc_id - The ID of the accepter VPC
 					//	peer_vpc_id - The ID of the requester VPC
@@ -51,6 +54,8 @@ func TestAccVPCPeeringConnectionAccepter_sameRegionSameAccount(t *testing.T) {
 					// resource.TestCheckResourceAttrPair(resourceNameAccepter, "peer_vpc_id", resourceNameMainVpc, "id"),
 					resource.TestCheckResourceAttrPair(resourceNameAccepter, "peer_owner_id", resourceNameMainVpc, "owner_id"),
 					resource.TestCheckResourceAttr(resourceNameAccepter, "peer_region", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "is_cross_account", "false"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "is_cross_region", "false"),
 					resource.TestCheckResourceAttr(resourceNameAccepter, "accept_status", "active"),
 				),
 			},
@@ -65,6 +70,36 @@ func TestAccVPCPeeringConnectionAccepter_sameRegionSameAccount(t *testing.T) {
 	})
 }
 
+func TestAccVPCPeeringConnectionAccepter_rejectOnDestroy(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	resourceNameAccepter := "aws_vpc_peering_connection_accepter.peer" // Accepter
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccVPCPeeringConnectionAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionAccepterConfig_rejectOnDestroy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceNameAccepter, &v),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "accept_status", "pending-acceptance"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "reject_on_destroy", "true"),
+				),
+			},
+			{
+				Config:                  testAccVPCPeeringConnectionAccepterConfig_rejectOnDestroy(rName),
+				ResourceName:            resourceNameAccepter,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_accept", "reject_on_destroy"},
+			},
+		},
+	})
+}
+
 func TestAccVPCPeeringConnectionAccepter_differentRegionSameAccount(t *testing.T) {
 	var vMain, vPeer ec2.VpcPeeringConnection
 	var providers []*schema.Provider
@@ -92,10 +127,16 @@ func TestAccVPCPeeringConnectionAccepter_differentRegionSameAccount(t *testing.T
 					resource.TestCheckResourceAttrPair(resourceNameConnection, "peer_vpc_id", resourceNamePeerVpc, "id"),
 					resource.TestCheckResourceAttrPair(resourceNameConnection, "peer_owner_id", resourceNamePeerVpc, "owner_id"),
 					resource.TestCheckResourceAttr(resourceNameConnection, "peer_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceNameConnection, "is_cross_account", "false"),
+					resource.TestCheckResourceAttr(resourceNameConnection, "is_cross_region", "true"),
 					// resource.TestCheckResourceAttrPair(resourceNameAccepter, "vpc_id", resourceNamePeerVpc, "id"),
 					// resource.TestCheckResourceAttrPair(resourceNameAccepter, "peer_vpc_id", resourceNameMainVpc, "id"),
 					resource.TestCheckResourceAttrPair(resourceNameAccepter, "peer_owner_id", resourceNameMainVpc, "owner_id"),
 					resource.TestCheckResourceAttr(resourceNameAccepter, "peer_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "is_cross_account", "false"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "is_cross_region", "true"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "accepter_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "requester_region", acctest.Region()),
 					resource.TestCheckResourceAttr(resourceNameAccepter, "accept_status", "active"),
 				),
 			},
@@ -110,6 +151,46 @@ func TestAccVPCPeeringConnectionAccepter_differentRegionSameAccount(t *testing.T
 	})
 }
 
+func TestAccVPCPeeringConnectionAccepter_differentRegionSameAccountOptions(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	resourceNameConnection := "aws_vpc_peering_connection.main"        // Requester
+	resourceNameAccepter := "aws_vpc_peering_connection_accepter.peer" // Accepter
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccVPCPeeringConnectionAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionAccepterConfig_differentRegionSameAccountOptions(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceNameConnection, &v),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "accept_status", "active"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "accepter.#", "1"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "accepter.0.allow_remote_vpc_dns_resolution", "false"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "requester.#", "1"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "requester.0.allow_remote_vpc_dns_resolution", "false"),
+				),
+			},
+			{
+				Config: testAccVPCPeeringConnectionAccepterConfig_differentRegionSameAccountOptions(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameAccepter, "accepter.#", "1"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "accepter.0.allow_remote_vpc_dns_resolution", "true"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "requester.#", "1"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "requester.0.allow_remote_vpc_dns_resolution", "true"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "peering_options_managed_by", "inline"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCPeeringConnectionAccepter_sameRegionDifferentAccount(t *testing.T) {
 	var v ec2.VpcPeeringConnection
 	resourceNameMainVpc := "aws_vpc.main"                              // Requester
@@ -135,10 +216,14 @@ func TestAccVPCPeeringConnectionAccepter_sameRegionDifferentAccount(t *testing.T
 					resource.TestCheckResourceAttrPair(resourceNameConnection, "peer_vpc_id", resourceNamePeerVpc, "id"),
 					resource.TestCheckResourceAttrPair(resourceNameConnection, "peer_owner_id", resourceNamePeerVpc, "owner_id"),
 					resource.TestCheckResourceAttr(resourceNameConnection, "peer_region", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceNameConnection, "is_cross_account", "true"),
+					resource.TestCheckResourceAttr(resourceNameConnection, "is_cross_region", "false"),
 					resource.TestCheckResourceAttrPair(resourceNameAccepter, "vpc_id", resourceNamePeerVpc, "id"),
 					resource.TestCheckResourceAttrPair(resourceNameAccepter, "peer_vpc_id", resourceNameMainVpc, "id"),
 					resource.TestCheckResourceAttrPair(resourceNameAccepter, "peer_owner_id", resourceNameMainVpc, "owner_id"),
 					resource.TestCheckResourceAttr(resourceNameAccepter, "peer_region", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "is_cross_account", "true"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "is_cross_region", "false"),
 					resource.TestCheckResourceAttr(resourceNameAccepter, "accept_status", "active"),
 				),
 			},
@@ -146,6 +231,58 @@ func TestAccVPCPeeringConnectionAccepter_sameRegionDifferentAccount(t *testing.T
 	})
 }
 
+func TestAccVPCPeeringConnectionAccepter_tagsDifferentAccount(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	resourceNameConnection := "aws_vpc_peering_connection.main"        // Requester
+	resourceNameAccepter := "aws_vpc_peering_connection_accepter.peer" // Accepter
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccVPCPeeringConnectionAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionAccepterConfig_tagsDifferentAccount(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceNameConnection, &v),
+					resource.TestCheckResourceAttr(resourceNameConnection, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceNameConnection, "tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceNameConnection, "tags.Side", "Requester"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "accept_status", "active"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "tags.Side", "Accepter"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnectionAccepter_requesterOptionsDifferentAccount(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccVPCPeeringConnectionAccepterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCPeeringConnectionAccepterConfig_requesterOptionsDifferentAccount(rName),
+				ExpectError: regexp.MustCompile("`requester` options cannot be modified from the accepter's account"),
+			},
+		},
+	})
+}
+
 func TestAccVPCPeeringConnectionAccepter_differentRegionDifferentAccount(t *testing.T) {
 	var v ec2.VpcPeeringConnection
 	resourceNameMainVpc := "aws_vpc.main"                              // Requester
@@ -172,10 +309,14 @@ func TestAccVPCPeeringConnectionAccepter_differentRegionDifferentAccount(t *test
 					resource.TestCheckResourceAttrPair(resourceNameConnection, "peer_vpc_id", resourceNamePeerVpc, "id"),
 					resource.TestCheckResourceAttrPair(resourceNameConnection, "peer_owner_id", resourceNamePeerVpc, "owner_id"),
 					resource.TestCheckResourceAttr(resourceNameConnection, "peer_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceNameConnection, "is_cross_account", "true"),
+					resource.TestCheckResourceAttr(resourceNameConnection, "is_cross_region", "true"),
 					resource.TestCheckResourceAttrPair(resourceNameAccepter, "vpc_id", resourceNamePeerVpc, "id"),
 					resource.TestCheckResourceAttrPair(resourceNameAccepter, "peer_vpc_id", resourceNameMainVpc, "id"),
 					resource.TestCheckResourceAttrPair(resourceNameAccepter, "peer_owner_id", resourceNameMainVpc, "owner_id"),
 					resource.TestCheckResourceAttr(resourceNameAccepter, "peer_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "is_cross_account", "true"),
+					resource.TestCheckResourceAttr(resourceNameAccepter, "is_cross_region", "true"),
 					resource.TestCheckResourceAttr(resourceNameAccepter, "accept_status", "active"),
 				),
 			},
@@ -229,6 +370,48 @@ resource "aws_vpc_peering_connection_accepter" "peer" {
 `, rName)
 }
 
+func testAccVPCPeeringConnectionAccepterConfig_rejectOnDestroy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+# Requester's side of the connection.
+resource "aws_vpc_peering_connection" "main" {
+  vpc_id      = aws_vpc.main.id
+  peer_vpc_id = aws_vpc.peer.id
+  auto_accept = false
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+# Accepter's side of the connection.
+resource "aws_vpc_peering_connection_accepter" "peer" {
+  vpc_peering_connection_id = aws_vpc_peering_connection.main.id
+  auto_accept               = false
+  reject_on_destroy         = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
 func testAccVPCPeeringConnectionAccepterConfig_differentRegionSameAccount(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
 resource "aws_vpc" "main" {
@@ -275,6 +458,62 @@ resource "aws_vpc_peering_connection_accepter" "peer" {
 `, rName, acctest.AlternateRegion()))
 }
 
+func testAccVPCPeeringConnectionAccepterConfig_differentRegionSameAccountOptions(rName string, dnsResolution bool) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
+resource "aws_vpc" "main" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_hostnames = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  provider = "awsalternate"
+
+  cidr_block           = "10.1.0.0/16"
+  enable_dns_hostnames = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+# Requester's side of the connection.
+resource "aws_vpc_peering_connection" "main" {
+  vpc_id      = aws_vpc.main.id
+  peer_vpc_id = aws_vpc.peer.id
+  peer_region = %[2]q
+  auto_accept = false
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+# Accepter's side of the connection.
+resource "aws_vpc_peering_connection_accepter" "peer" {
+  provider = "awsalternate"
+
+  vpc_peering_connection_id = aws_vpc_peering_connection.main.id
+  auto_accept               = true
+
+  accepter {
+    allow_remote_vpc_dns_resolution = %[3]t
+  }
+
+  requester {
+    allow_remote_vpc_dns_resolution = %[3]t
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, acctest.AlternateRegion(), dnsResolution))
+}
+
 func testAccVPCPeeringConnectionAccepterConfig_sameRegionDifferentAccount(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
 resource "aws_vpc" "main" {
@@ -326,6 +565,114 @@ resource "aws_vpc_peering_connection_accepter" "peer" {
 `, rName, acctest.Region()))
 }
 
+func testAccVPCPeeringConnectionAccepterConfig_requesterOptionsDifferentAccount(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+resource "aws_vpc" "main" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_hostnames = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  provider = "awsalternate"
+
+  cidr_block           = "10.1.0.0/16"
+  enable_dns_hostnames = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_caller_identity" "peer" {
+  provider = "awsalternate"
+}
+
+# Requester's side of the connection.
+resource "aws_vpc_peering_connection" "main" {
+  vpc_id        = aws_vpc.main.id
+  peer_vpc_id   = aws_vpc.peer.id
+  peer_owner_id = data.aws_caller_identity.peer.account_id
+  auto_accept   = false
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+# Accepter's side of the connection.
+resource "aws_vpc_peering_connection_accepter" "peer" {
+  provider = "awsalternate"
+
+  vpc_peering_connection_id = aws_vpc_peering_connection.main.id
+  auto_accept               = true
+
+  requester {
+    allow_remote_vpc_dns_resolution = true
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccVPCPeeringConnectionAccepterConfig_tagsDifferentAccount(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+resource "aws_vpc" "main" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  provider = "awsalternate"
+
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_caller_identity" "peer" {
+  provider = "awsalternate"
+}
+
+# Requester's side of the connection.
+resource "aws_vpc_peering_connection" "main" {
+  vpc_id        = aws_vpc.main.id
+  peer_vpc_id   = aws_vpc.peer.id
+  peer_owner_id = data.aws_caller_identity.peer.account_id
+  auto_accept   = false
+
+  tags = {
+    Name = %[1]q
+    Side = "Requester"
+  }
+}
+
+# Accepter's side of the connection.
+resource "aws_vpc_peering_connection_accepter" "peer" {
+  provider = "awsalternate"
+
+  vpc_peering_connection_id = aws_vpc_peering_connection.main.id
+  auto_accept               = true
+
+  tags = {
+    Name = %[1]q
+    Side = "Accepter"
+  }
+}
+`, rName))
+}
+
 func testAccVPCPeeringConnectionAccepterConfig_differentRegionDifferentAccount(rName string) string {
 	return acctest.ConfigCompose(testAccAlternateAccountAlternateRegionProviderConfig(), fmt.Sprintf(`
 resource "aws_vpc" "main" {
@@ -1,26 +1,62 @@
 package ec2
 
 import (
-	"fmt"
+	"context"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func DataSourceVPCPeeringConnection() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceVPCPeeringConnectionRead,
+		ReadWithoutTimeout: dataSourceVPCPeeringConnectionRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
+			// An alias of status, named after the resource's attribute.
+			"accept_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"accept_status_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"accepter": {
 				Type:     schema.TypeMap,
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeBool},
 			},
+			"by_route": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"id"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_cidr_block": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+						},
+						"route_table_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			"cidr_block": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -38,12 +74,25 @@ func DataSourceVPCPeeringConnection() *schema.Resource {
 					},
 				},
 			},
+			"dns_resolution_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"expiration_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"filter": CustomFiltersSchema(),
 			"id": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
 			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"owner_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -102,11 +151,16 @@ func DataSourceVPCPeeringConnection() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"wait_for_active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
 
-func dataSourceVPCPeeringConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceVPCPeeringConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
@@ -116,6 +170,27 @@ func dataSourceVPCPeeringConnectionRead(d *schema.ResourceData, meta interface{}
 		input.VpcPeeringConnectionIds = aws.StringSlice([]string{v.(string)})
 	}
 
+	// The VPC Peering Connection can be looked up by a route that targets it.
+	if v, ok := d.GetOk("by_route"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		routeTableID := tfMap["route_table_id"].(string)
+		destination := tfMap["destination_cidr_block"].(string)
+
+		route, err := FindRouteByIPv4Destination(conn, routeTableID, destination)
+
+		if err != nil {
+			return diag.Errorf("error reading Route in Route Table (%s) with destination (%s): %s", routeTableID, destination, err)
+		}
+
+		vpcPeeringConnectionID := aws.StringValue(route.VpcPeeringConnectionId)
+
+		if vpcPeeringConnectionID == "" {
+			return diag.Errorf("Route in Route Table (%s) with destination (%s) does not target an EC2 VPC Peering Connection", routeTableID, destination)
+		}
+
+		input.VpcPeeringConnectionIds = aws.StringSlice([]string{vpcPeeringConnectionID})
+	}
+
 	input.Filters = BuildAttributeFilterList(
 		map[string]string{
 			"status-code":                   d.Get("status").(string),
@@ -125,6 +200,7 @@ func dataSourceVPCPeeringConnectionRead(d *schema.ResourceData, meta interface{}
 			"accepter-vpc-info.vpc-id":      d.Get("peer_vpc_id").(string),
 			"accepter-vpc-info.owner-id":    d.Get("peer_owner_id").(string),
 			"accepter-vpc-info.cidr-block":  d.Get("peer_cidr_block").(string),
+			"tag:Name":                      d.Get("name").(string),
 		},
 	)
 
@@ -142,13 +218,48 @@ func dataSourceVPCPeeringConnectionRead(d *schema.ResourceData, meta interface{}
 		input.Filters = nil
 	}
 
-	vpcPeeringConnection, err := FindVPCPeeringConnection(conn, input)
+	// The full result set is read so that the IDs of ambiguous matches can be reported.
+	vpcPeeringConnections, err := FindVPCPeeringConnections(ctx, conn, input)
 
 	if err != nil {
-		return tfresource.SingularDataSourceFindError("EC2 VPC Peering Connection", err)
+		return diag.FromErr(tfresource.SingularDataSourceFindError("EC2 VPC Peering Connection", err))
+	}
+
+	if count := len(vpcPeeringConnections); count > 1 {
+		ids := make([]string, 0, count)
+
+		for _, v := range vpcPeeringConnections {
+			ids = append(ids, aws.StringValue(v.VpcPeeringConnectionId))
+		}
+
+		return diag.Errorf("multiple EC2 VPC Peering Connections matched (%s); use additional constraints to reduce matches to a single EC2 VPC Peering Connection", strings.Join(ids, ", "))
+	}
+
+	if len(vpcPeeringConnections) == 0 || vpcPeeringConnections[0].Status == nil {
+		return diag.FromErr(tfresource.SingularDataSourceFindError("EC2 VPC Peering Connection", tfresource.NewEmptyResultError(input)))
+	}
+
+	vpcPeeringConnection := vpcPeeringConnections[0]
+
+	if d.Get("wait_for_active").(bool) && aws.StringValue(vpcPeeringConnection.Status.Code) != ec2.VpcPeeringConnectionStateReasonCodeActive {
+		id := aws.StringValue(vpcPeeringConnection.VpcPeeringConnectionId)
+
+		vpcPeeringConnection, err = WaitVPCPeeringConnectionAccepted(ctx, conn, id, d.Timeout(schema.TimeoutRead))
+
+		if err != nil {
+			return diag.Errorf("error waiting for EC2 VPC Peering Connection (%s) to become active: %s", id, err)
+		}
 	}
 
 	d.SetId(aws.StringValue(vpcPeeringConnection.VpcPeeringConnectionId))
+	d.Set("accept_status", vpcPeeringConnection.Status.Code)
+	d.Set("accept_status_message", vpcPeeringConnection.Status.Message)
+	d.Set("dns_resolution_enabled", vpcPeeringConnectionDNSResolutionEnabled(vpcPeeringConnection))
+	if v := vpcPeeringConnection.ExpirationTime; v != nil && aws.StringValue(vpcPeeringConnection.Status.Code) == ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance {
+		d.Set("expiration_time", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("expiration_time", nil)
+	}
 	d.Set("status", vpcPeeringConnection.Status.Code)
 	d.Set("vpc_id", vpcPeeringConnection.RequesterVpcInfo.VpcId)
 	d.Set("owner_id", vpcPeeringConnection.RequesterVpcInfo.OwnerId)
@@ -161,7 +272,7 @@ func dataSourceVPCPeeringConnectionRead(d *schema.ResourceData, meta interface{}
 		})
 	}
 	if err := d.Set("cidr_block_set", cidrBlockSet); err != nil {
-		return fmt.Errorf("error setting cidr_block_set: %w", err)
+		return diag.Errorf("error setting cidr_block_set: %s", err)
 	}
 
 	d.Set("region", vpcPeeringConnection.RequesterVpcInfo.Region)
@@ -176,24 +287,28 @@ func dataSourceVPCPeeringConnectionRead(d *schema.ResourceData, meta interface{}
 		})
 	}
 	if err := d.Set("peer_cidr_block_set", peerCidrBlockSet); err != nil {
-		return fmt.Errorf("error setting peer_cidr_block_set: %w", err)
+		return diag.Errorf("error setting peer_cidr_block_set: %s", err)
 	}
 
 	d.Set("peer_region", vpcPeeringConnection.AccepterVpcInfo.Region)
 
-	if err := d.Set("tags", KeyValueTags(vpcPeeringConnection.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
-		return fmt.Errorf("error setting tags: %w", err)
+	tags := KeyValueTags(vpcPeeringConnection.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	d.Set("name", tags.KeyValue("Name"))
+
+	if err := d.Set("tags", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
 	}
 
 	if vpcPeeringConnection.AccepterVpcInfo.PeeringOptions != nil {
 		if err := d.Set("accepter", flattenVPCPeeringConnectionOptionsDescription(vpcPeeringConnection.AccepterVpcInfo.PeeringOptions)); err != nil {
-			return fmt.Errorf("error setting accepter: %w", err)
+			return diag.Errorf("error setting accepter: %s", err)
 		}
 	}
 
 	if vpcPeeringConnection.RequesterVpcInfo.PeeringOptions != nil {
 		if err := d.Set("requester", flattenVPCPeeringConnectionOptionsDescription(vpcPeeringConnection.RequesterVpcInfo.PeeringOptions)); err != nil {
-			return fmt.Errorf("error setting requester: %w", err)
+			return diag.Errorf("error setting requester: %s", err)
 		}
 	}
 
@@ -1,15 +1,203 @@
 package ec2_test
 
 import (
+	"context"
 	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 )
 
+func TestDataSourceVPCPeeringConnectionReadMultipleResults(t *testing.T) {
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{
+			{
+				Status:                 &ec2.VpcPeeringConnectionStateReason{Code: aws.String(ec2.VpcPeeringConnectionStateReasonCodeActive)},
+				VpcPeeringConnectionId: aws.String("pcx-11111111"),
+			},
+			{
+				Status:                 &ec2.VpcPeeringConnectionStateReason{Code: aws.String(ec2.VpcPeeringConnectionStateReasonCodeActive)},
+				VpcPeeringConnectionId: aws.String("pcx-22222222"),
+			},
+		}
+	})
+
+	ds := tfec2.DataSourceVPCPeeringConnection()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"vpc_id": "vpc-12345678",
+	})
+
+	diags := ds.ReadWithoutTimeout(context.Background(), d, &conns.AWSClient{EC2Conn: conn, Region: endpoints.UsWest2RegionID})
+
+	if !diags.HasError() {
+		t.Fatal("expected error, got none")
+	}
+
+	if got, want := diags[0].Summary, "multiple EC2 VPC Peering Connections matched (pcx-11111111, pcx-22222222)"; !strings.Contains(got, want) {
+		t.Errorf("expected error containing %q, got: %s", want, got)
+	}
+}
+
+func TestDataSourceVPCPeeringConnectionReadStatusNoMatch(t *testing.T) {
+	var filters []*ec2.Filter
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		// The only VPC Peering Connection between the VPCs has failed, so none match the status constraint.
+		filters = r.Params.(*ec2.DescribeVpcPeeringConnectionsInput).Filters
+	})
+
+	ds := tfec2.DataSourceVPCPeeringConnection()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"peer_vpc_id": "vpc-11111111",
+		"status":      ec2.VpcPeeringConnectionStateReasonCodeActive,
+		"vpc_id":      "vpc-22222222",
+	})
+
+	diags := ds.ReadWithoutTimeout(context.Background(), d, &conns.AWSClient{EC2Conn: conn, Region: endpoints.UsWest2RegionID})
+
+	var statusCodes []string
+	for _, filter := range filters {
+		if aws.StringValue(filter.Name) == "status-code" {
+			statusCodes = aws.StringValueSlice(filter.Values)
+		}
+	}
+
+	if got, want := strings.Join(statusCodes, ","), ec2.VpcPeeringConnectionStateReasonCodeActive; got != want {
+		t.Errorf("got status-code filter %q, expected %q", got, want)
+	}
+
+	if !diags.HasError() {
+		t.Fatal("expected error, got none")
+	}
+
+	if got, want := diags[0].Summary, "no matching EC2 VPC Peering Connection found"; got != want {
+		t.Errorf("expected error %q, got: %s", want, got)
+	}
+}
+
+func TestDataSourceVPCPeeringConnectionReadByRoute(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		route                *ec2.Route
+		expectedErrorText    string
+		expectedID           string
+		expectedDescribedIDs []string
+	}{
+		{
+			name: "peering connection target",
+			route: &ec2.Route{
+				DestinationCidrBlock:   aws.String("10.2.0.0/16"),
+				State:                  aws.String(ec2.RouteStateActive),
+				VpcPeeringConnectionId: aws.String("pcx-12345678"),
+			},
+			expectedID:           "pcx-12345678",
+			expectedDescribedIDs: []string{"pcx-12345678"},
+		},
+		{
+			name: "other target",
+			route: &ec2.Route{
+				DestinationCidrBlock: aws.String("10.2.0.0/16"),
+				GatewayId:            aws.String("igw-12345678"),
+				State:                aws.String(ec2.RouteStateActive),
+			},
+			expectedErrorText: "Route in Route Table (rtb-12345678) with destination (10.2.0.0/16) does not target an EC2 VPC Peering Connection",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			var describedIDs []string
+			conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+				switch r.Operation.Name {
+				case "DescribeRouteTables":
+					r.Data.(*ec2.DescribeRouteTablesOutput).RouteTables = []*ec2.RouteTable{{
+						RouteTableId: aws.String("rtb-12345678"),
+						Routes:       []*ec2.Route{testCase.route},
+					}}
+				case "DescribeVpcPeeringConnections":
+					describedIDs = aws.StringValueSlice(r.Params.(*ec2.DescribeVpcPeeringConnectionsInput).VpcPeeringConnectionIds)
+
+					r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{{
+						AccepterVpcInfo:        &ec2.VpcPeeringConnectionVpcInfo{VpcId: aws.String("vpc-11111111")},
+						RequesterVpcInfo:       &ec2.VpcPeeringConnectionVpcInfo{VpcId: aws.String("vpc-22222222")},
+						Status:                 &ec2.VpcPeeringConnectionStateReason{Code: aws.String(ec2.VpcPeeringConnectionStateReasonCodeActive)},
+						VpcPeeringConnectionId: aws.String("pcx-12345678"),
+					}}
+				default:
+					t.Errorf("unexpected operation: %s", r.Operation.Name)
+				}
+			})
+
+			ds := tfec2.DataSourceVPCPeeringConnection()
+			d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+				"by_route": []interface{}{map[string]interface{}{
+					"destination_cidr_block": "10.2.0.0/16",
+					"route_table_id":         "rtb-12345678",
+				}},
+			})
+
+			diags := ds.ReadWithoutTimeout(context.Background(), d, &conns.AWSClient{EC2Conn: conn, Region: endpoints.UsWest2RegionID})
+
+			if testCase.expectedErrorText != "" {
+				if !diags.HasError() {
+					t.Fatal("expected error, got none")
+				}
+
+				if got, want := diags[0].Summary, testCase.expectedErrorText; got != want {
+					t.Errorf("expected error %q, got: %s", want, got)
+				}
+
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := describedIDs, testCase.expectedDescribedIDs; !reflect.DeepEqual(got, want) {
+				t.Errorf("got described IDs %v, expected %v", got, want)
+			}
+
+			if got, want := d.Id(), testCase.expectedID; got != want {
+				t.Errorf("got ID %q, expected %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAccVPCPeeringConnectionDataSource_byRoute(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_peering_connection.test"
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionDataSourceConfig_byRoute(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "peer_vpc_id", resourceName, "peer_vpc_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_id", resourceName, "vpc_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCPeeringConnectionDataSource_cidrBlock(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSourceName := "data.aws_vpc_peering_connection.test"
@@ -48,6 +236,9 @@ func TestAccVPCPeeringConnectionDataSource_id(t *testing.T) {
 				Config: testAccVPCPeeringConnectionDataSourceConfig_id(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "accept_status", resourceName, "accept_status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "accept_status", dataSourceName, "status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "accept_status_message", resourceName, "accept_status_message"),
 					// resource.TestCheckResourceAttrPair(dataSourceName, "cidr_block", resourceName, "cidr_block"), // not in resource
 					resource.TestCheckResourceAttrPair(dataSourceName, "cidr_block", requesterVpcResourceName, "cidr_block"),
 					// resource.TestCheckResourceAttrPair(dataSourceName, "cidr_block_set.#", resourceName, "cidr_block_set.#"), // not in resource
@@ -72,6 +263,28 @@ func TestAccVPCPeeringConnectionDataSource_id(t *testing.T) {
 	})
 }
 
+func TestAccVPCPeeringConnectionDataSource_name(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_peering_connection.test"
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionDataSourceConfig_name(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.Name", resourceName, "tags.Name"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCPeeringConnectionDataSource_peerCIDRBlock(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSourceName := "data.aws_vpc_peering_connection.test"
@@ -136,6 +349,101 @@ func TestAccVPCPeeringConnectionDataSource_vpcID(t *testing.T) {
 	})
 }
 
+func TestAccVPCPeeringConnectionDataSource_vpcPair(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_peering_connection.test"
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionDataSourceConfig_vpcPair(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_id", resourceName, "vpc_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "peer_vpc_id", resourceName, "peer_vpc_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnectionDataSource_waitForActive(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_peering_connection.test"
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionDataSourceConfig_waitForActive(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "status", ec2.VpcPeeringConnectionStateReasonCodeActive),
+					resource.TestCheckResourceAttr(dataSourceName, "accept_status", ec2.VpcPeeringConnectionStateReasonCodeActive),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCPeeringConnectionDataSourceConfig_byRoute(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "requester" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "accepter" {
+  cidr_block = "10.2.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.requester.id
+  peer_vpc_id = aws_vpc.accepter.id
+  auto_accept = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.requester.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection_route" "test" {
+  route_table_id            = aws_route_table.test.id
+  destination_cidr_block    = aws_vpc.accepter.cidr_block
+  vpc_peering_connection_id = aws_vpc_peering_connection.test.id
+}
+
+data "aws_vpc_peering_connection" "test" {
+  by_route {
+    route_table_id         = aws_vpc_peering_connection_route.test.route_table_id
+    destination_cidr_block = aws_vpc_peering_connection_route.test.destination_cidr_block
+  }
+}
+`, rName)
+}
+
 func testAccVPCPeeringConnectionDataSourceConfig_cidrBlock(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "requester" {
@@ -210,6 +518,40 @@ data "aws_vpc_peering_connection" "test" {
 `, rName)
 }
 
+func testAccVPCPeeringConnectionDataSourceConfig_name(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "requester" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "accepter" {
+  cidr_block = "10.2.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.requester.id
+  peer_vpc_id = aws_vpc.accepter.id
+  auto_accept = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_vpc_peering_connection" "test" {
+  name = aws_vpc_peering_connection.test.tags["Name"]
+}
+`, rName)
+}
+
 func testAccVPCPeeringConnectionDataSourceConfig_peerCIDRBlock(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "requester" {
@@ -317,3 +659,79 @@ data "aws_vpc_peering_connection" "test" {
 }
 `, rName)
 }
+
+func testAccVPCPeeringConnectionDataSourceConfig_vpcPair(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "requester" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "accepter" {
+  cidr_block = "10.2.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.requester.id
+  peer_vpc_id = aws_vpc.accepter.id
+  auto_accept = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc_peering_connection.test.vpc_id
+  peer_vpc_id = aws_vpc_peering_connection.test.peer_vpc_id
+  status      = "active"
+}
+`, rName)
+}
+
+func testAccVPCPeeringConnectionDataSourceConfig_waitForActive(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "requester" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "accepter" {
+  cidr_block = "10.2.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.requester.id
+  peer_vpc_id = aws_vpc.accepter.id
+  auto_accept = false
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection_accepter" "test" {
+  vpc_peering_connection_id = aws_vpc_peering_connection.test.id
+  auto_accept               = true
+}
+
+data "aws_vpc_peering_connection" "test" {
+  id              = aws_vpc_peering_connection.test.id
+  wait_for_active = true
+}
+`, rName)
+}
@@ -0,0 +1,77 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceVPCPeeringConnectionDryRun() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceVPCPeeringConnectionDryRunRead,
+
+		Schema: map[string]*schema.Schema{
+			"peer_owner_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"peer_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"peer_vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceVPCPeeringConnectionDryRunRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	vpcID, peerVPCID := d.Get("vpc_id").(string), d.Get("peer_vpc_id").(string)
+	input := &ec2.CreateVpcPeeringConnectionInput{
+		DryRun:    aws.Bool(true),
+		PeerVpcId: aws.String(peerVPCID),
+		VpcId:     aws.String(vpcID),
+	}
+
+	if v, ok := d.GetOk("peer_owner_id"); ok {
+		input.PeerOwnerId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("peer_region"); ok {
+		input.PeerRegion = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating EC2 VPC Peering Connection (dry run): %s", input)
+	_, err := conn.CreateVpcPeeringConnectionWithContext(ctx, input)
+
+	// "DryRunOperation: Request would have succeeded, but DryRun flag is set."
+	if !tfawserr.ErrCodeEquals(err, errCodeDryRunOperation) {
+		if err == nil {
+			err = fmt.Errorf("unexpected success")
+		}
+
+		return diag.Errorf("EC2 VPC Peering Connection dry run (%s to %s) failed: %s", vpcID, peerVPCID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", vpcID, peerVPCID))
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestDataSourceVPCPeeringConnectionDryRunRead(t *testing.T) {
+	testCases := map[string]struct {
+		err               error
+		expectedErrorText string
+	}{
+		"allowed": {
+			err: awserr.New("DryRunOperation", "Request would have succeeded, but DryRun flag is set.", nil),
+		},
+		"unauthorized": {
+			err:               awserr.New("UnauthorizedOperation", "You are not authorized to perform this operation.", nil),
+			expectedErrorText: "UnauthorizedOperation",
+		},
+		"invalid VPC": {
+			err:               awserr.New("InvalidVpcID.NotFound", "The vpc ID 'vpc-11111111' does not exist", nil),
+			expectedErrorText: "InvalidVpcID.NotFound",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+				if got, want := r.Operation.Name, "CreateVpcPeeringConnection"; got != want {
+					t.Errorf("unexpected operation: %s", got)
+				}
+
+				if !aws.BoolValue(r.Params.(*ec2.CreateVpcPeeringConnectionInput).DryRun) {
+					t.Errorf("expected DryRun to be set")
+				}
+
+				r.Error = testCase.err
+			})
+
+			ds := tfec2.DataSourceVPCPeeringConnectionDryRun()
+			d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+				"peer_vpc_id": "vpc-11111111",
+				"vpc_id":      "vpc-22222222",
+			})
+
+			diags := ds.ReadWithoutTimeout(context.Background(), d, &conns.AWSClient{EC2Conn: conn})
+
+			if testCase.expectedErrorText == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+
+				if got, want := d.Id(), "vpc-22222222:vpc-11111111"; got != want {
+					t.Errorf("got ID %q, expected %q", got, want)
+				}
+
+				return
+			}
+
+			if !diags.HasError() || !strings.Contains(diags[0].Summary, testCase.expectedErrorText) {
+				t.Errorf("expected error containing %q, got: %v", testCase.expectedErrorText, diags)
+			}
+		})
+	}
+}
+
+func TestAccVPCPeeringConnectionDryRunDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_peering_connection_dry_run.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionDryRunDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_id", "aws_vpc.requester", "id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "peer_vpc_id", "aws_vpc.accepter", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCPeeringConnectionDryRunDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "requester" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "accepter" {
+  cidr_block = "10.2.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_vpc_peering_connection_dry_run" "test" {
+  vpc_id      = aws_vpc.requester.id
+  peer_vpc_id = aws_vpc.accepter.id
+}
+`, rName)
+}
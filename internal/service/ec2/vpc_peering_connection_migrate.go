@@ -0,0 +1,124 @@
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func resourceVPCPeeringConnectionV0() *schema.Resource {
+	return &schema.Resource{
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(1 * time.Minute),
+			Update: schema.DefaultTimeout(1 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accept_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"accepter": vpcPeeringConnectionOptionsSchemaV0,
+			"auto_accept": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"peer_owner_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"peer_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"peer_vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"requester": vpcPeeringConnectionOptionsSchemaV0,
+			"tags":      tftags.TagsSchema(),
+			"tags_all":  tftags.TagsSchemaComputed(),
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+var vpcPeeringConnectionOptionsSchemaV0 = &schema.Schema{
+	Type:     schema.TypeList,
+	Optional: true,
+	Computed: true,
+	MaxItems: 1,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"allow_classic_link_to_remote_vpc": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"allow_remote_vpc_dns_resolution": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"allow_vpc_to_remote_classic_link": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	},
+}
+
+func VPCPeeringConnectionStateUpgradeV0(_ context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		rawState = map[string]interface{}{}
+	}
+
+	for _, key := range []string{"accepter", "requester"} {
+		if v, ok := rawState[key]; ok {
+			rawState[key] = upgradeVPCPeeringConnectionOptionsV0(v)
+		}
+	}
+
+	return rawState, nil
+}
+
+// upgradeVPCPeeringConnectionOptionsV0 normalizes v0 accepter or requester options
+// into a list of at most one options object with every attribute set.
+func upgradeVPCPeeringConnectionOptionsV0(v interface{}) []interface{} {
+	var tfMap map[string]interface{}
+
+	if v, ok := v.([]interface{}); ok {
+		for _, v := range v {
+			if v, ok := v.(map[string]interface{}); ok {
+				tfMap = v
+				break
+			}
+		}
+	}
+
+	if len(tfMap) == 0 {
+		return []interface{}{}
+	}
+
+	apiObject := map[string]interface{}{}
+
+	for _, key := range []string{"allow_classic_link_to_remote_vpc", "allow_remote_vpc_dns_resolution", "allow_vpc_to_remote_classic_link"} {
+		v, _ := tfMap[key].(bool)
+		apiObject[key] = v
+	}
+
+	return []interface{}{apiObject}
+}
@@ -0,0 +1,100 @@
+package ec2_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+// testVPCPeeringConnectionStateDataV0 is the attributes object of an
+// aws_vpc_peering_connection resource written by a schema version 0 provider.
+const testVPCPeeringConnectionStateDataV0 = `{
+  "accept_status": "active",
+  "accepter": [
+    {
+      "allow_classic_link_to_remote_vpc": false,
+      "allow_remote_vpc_dns_resolution": true,
+      "allow_vpc_to_remote_classic_link": false
+    }
+  ],
+  "auto_accept": true,
+  "id": "pcx-12345678",
+  "peer_owner_id": "111111111111",
+  "peer_region": "us-west-2",
+  "peer_vpc_id": "vpc-11111111",
+  "requester": [],
+  "tags": {
+    "Name": "tf-acc-test"
+  },
+  "tags_all": {
+    "Name": "tf-acc-test"
+  },
+  "timeouts": null,
+  "vpc_id": "vpc-22222222"
+}`
+
+func testVPCPeeringConnectionStateDataV1() map[string]interface{} {
+	return map[string]interface{}{
+		"accept_status": "active",
+		"accepter": []interface{}{
+			map[string]interface{}{
+				"allow_classic_link_to_remote_vpc": false,
+				"allow_remote_vpc_dns_resolution":  true,
+				"allow_vpc_to_remote_classic_link": false,
+			},
+		},
+		"auto_accept":   true,
+		"id":            "pcx-12345678",
+		"peer_owner_id": "111111111111",
+		"peer_region":   "us-west-2",
+		"peer_vpc_id":   "vpc-11111111",
+		"requester":     []interface{}{},
+		"tags": map[string]interface{}{
+			"Name": "tf-acc-test",
+		},
+		"tags_all": map[string]interface{}{
+			"Name": "tf-acc-test",
+		},
+		"timeouts": nil,
+		"vpc_id":   "vpc-22222222",
+	}
+}
+
+func TestVPCPeeringConnectionStateUpgradeV0(t *testing.T) {
+	r := tfec2.ResourceVPCPeeringConnection()
+
+	if _, err := ctyjson.Unmarshal([]byte(testVPCPeeringConnectionStateDataV0), r.StateUpgraders[0].Type); err != nil {
+		t.Fatalf("error decoding v0 state: %s", err)
+	}
+
+	var rawState map[string]interface{}
+
+	if err := json.Unmarshal([]byte(testVPCPeeringConnectionStateDataV0), &rawState); err != nil {
+		t.Fatalf("error unmarshaling v0 state: %s", err)
+	}
+
+	expected := testVPCPeeringConnectionStateDataV1()
+	actual, err := tfec2.VPCPeeringConnectionStateUpgradeV0(context.Background(), rawState, nil)
+
+	if err != nil {
+		t.Fatalf("error migrating state: %s", err)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, actual)
+	}
+
+	v, err := json.Marshal(actual)
+
+	if err != nil {
+		t.Fatalf("error marshaling v1 state: %s", err)
+	}
+
+	if _, err := ctyjson.Unmarshal(v, r.CoreConfigSchema().ImpliedType()); err != nil {
+		t.Fatalf("error decoding v1 state: %s", err)
+	}
+}
@@ -1,9 +1,13 @@
 package ec2
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -11,17 +15,23 @@ import (
 
 func ResourceVPCPeeringConnectionOptions() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceVPCPeeringConnectionOptionsCreate,
-		Read:   resourceVPCPeeringConnectionOptionsRead,
-		Update: resourceVPCPeeringConnectionOptionsUpdate,
-		Delete: resourceVPCPeeringConnectionOptionsDelete,
+		CreateWithoutTimeout: resourceVPCPeeringConnectionOptionsCreate,
+		ReadWithoutTimeout:   resourceVPCPeeringConnectionOptionsRead,
+		UpdateWithoutTimeout: resourceVPCPeeringConnectionOptionsUpdate,
+		DeleteWithoutTimeout: resourceVPCPeeringConnectionOptionsDelete,
+
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: resourceVPCPeeringConnectionOptionsImport,
 		},
 
 		Schema: map[string]*schema.Schema{
 			"accepter":  vpcPeeringConnectionOptionsSchema,
 			"requester": vpcPeeringConnectionOptionsSchema,
+			"skip_options_wait": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"vpc_peering_connection_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -31,29 +41,29 @@ func ResourceVPCPeeringConnectionOptions() *schema.Resource {
 	}
 }
 
-func resourceVPCPeeringConnectionOptionsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceVPCPeeringConnectionOptionsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
 	vpcPeeringConnectionID := d.Get("vpc_peering_connection_id").(string)
-	vpcPeeringConnection, err := FindVPCPeeringConnectionByID(conn, vpcPeeringConnectionID)
+	vpcPeeringConnection, err := FindVPCPeeringConnectionByID(ctx, conn, vpcPeeringConnectionID)
 
 	if err != nil {
-		return fmt.Errorf("error reading EC2 VPC Peering Connection (%s): %w", vpcPeeringConnectionID, err)
+		return diag.Errorf("error reading EC2 VPC Peering Connection (%s): %s", vpcPeeringConnectionID, err)
 	}
 
 	d.SetId(vpcPeeringConnectionID)
 
-	if err := modifyVPCPeeringConnectionOptions(conn, d, vpcPeeringConnection, false); err != nil {
-		return err
+	if _, err := modifyVPCPeeringConnectionOptions(ctx, conn, conn, d, vpcPeeringConnection, false); err != nil {
+		return diag.FromErr(err)
 	}
 
-	return resourceVPCPeeringConnectionOptionsRead(d, meta)
+	return resourceVPCPeeringConnectionOptionsRead(ctx, d, meta)
 }
 
-func resourceVPCPeeringConnectionOptionsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceVPCPeeringConnectionOptionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
-	vpcPeeringConnection, err := FindVPCPeeringConnectionByID(conn, d.Id())
+	vpcPeeringConnection, err := FindVPCPeeringConnectionByID(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] EC2 VPC Peering Connection Options %s not found, removing from state", d.Id())
@@ -62,21 +72,88 @@ func resourceVPCPeeringConnectionOptionsRead(d *schema.ResourceData, meta interf
 	}
 
 	if err != nil {
-		return fmt.Errorf("error reading EC2 VPC Peering Connection Options (%s): %w", d.Id(), err)
+		return diag.Errorf("error reading EC2 VPC Peering Connection Options (%s): %s", d.Id(), err)
 	}
 
 	d.Set("vpc_peering_connection_id", vpcPeeringConnection.VpcPeeringConnectionId)
 
-	if vpcPeeringConnection.AccepterVpcInfo.PeeringOptions != nil {
-		if err := d.Set("accepter", []interface{}{flattenVPCPeeringConnectionOptionsDescription(vpcPeeringConnection.AccepterVpcInfo.PeeringOptions)}); err != nil {
+	if err := setVPCPeeringConnectionOptions(ctx, d, meta, vpcPeeringConnection); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceVPCPeeringConnectionOptionsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	vpcPeeringConnection, err := FindVPCPeeringConnectionByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return diag.Errorf("error reading EC2 VPC Peering Connection (%s): %s", d.Id(), err)
+	}
+
+	if _, err := modifyVPCPeeringConnectionOptions(ctx, conn, conn, d, vpcPeeringConnection, false); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceVPCPeeringConnectionOptionsRead(ctx, d, meta)
+}
+
+func resourceVPCPeeringConnectionOptionsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Don't do anything with the underlying VPC Peering Connection.
+	return nil
+}
+
+func resourceVPCPeeringConnectionOptionsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	vpcPeeringConnection, err := FindVPCPeeringConnectionByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading EC2 VPC Peering Connection (%s): %w", d.Id(), err)
+	}
+
+	d.Set("skip_options_wait", false)
+	d.Set("vpc_peering_connection_id", vpcPeeringConnection.VpcPeeringConnectionId)
+
+	if err := setVPCPeeringConnectionOptions(ctx, d, meta, vpcPeeringConnection); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// setVPCPeeringConnectionOptions sets the accepter and requester options of the specified VPC Peering Connection.
+// The options of the side of a cross-region VPC Peering Connection that isn't in the provider's region can be
+// missing from the VPC Peering Connection's description, in which case they're read in that side's region.
+func setVPCPeeringConnectionOptions(ctx context.Context, d *schema.ResourceData, meta interface{}, vpcPeeringConnection *ec2.VpcPeeringConnection) error {
+	id := aws.StringValue(vpcPeeringConnection.VpcPeeringConnectionId)
+
+	accepterOptions := vpcPeeringConnection.AccepterVpcInfo.PeeringOptions
+	if accepterOptions == nil {
+		if v := findVPCPeeringConnectionInOtherRegion(ctx, meta, id, aws.StringValue(vpcPeeringConnection.AccepterVpcInfo.Region)); v != nil && v.AccepterVpcInfo != nil {
+			accepterOptions = v.AccepterVpcInfo.PeeringOptions
+		}
+	}
+
+	if accepterOptions != nil {
+		if err := d.Set("accepter", []interface{}{flattenVPCPeeringConnectionOptionsDescription(accepterOptions)}); err != nil {
 			return fmt.Errorf("error setting accepter: %w", err)
 		}
 	} else {
 		d.Set("accepter", nil)
 	}
 
-	if vpcPeeringConnection.RequesterVpcInfo.PeeringOptions != nil {
-		if err := d.Set("requester", []interface{}{flattenVPCPeeringConnectionOptionsDescription(vpcPeeringConnection.RequesterVpcInfo.PeeringOptions)}); err != nil {
+	requesterOptions := vpcPeeringConnection.RequesterVpcInfo.PeeringOptions
+	if requesterOptions == nil {
+		if v := findVPCPeeringConnectionInOtherRegion(ctx, meta, id, aws.StringValue(vpcPeeringConnection.RequesterVpcInfo.Region)); v != nil && v.RequesterVpcInfo != nil {
+			requesterOptions = v.RequesterVpcInfo.PeeringOptions
+		}
+	}
+
+	if requesterOptions != nil {
+		if err := d.Set("requester", []interface{}{flattenVPCPeeringConnectionOptionsDescription(requesterOptions)}); err != nil {
 			return fmt.Errorf("error setting requester: %w", err)
 		}
 	} else {
@@ -86,23 +163,28 @@ func resourceVPCPeeringConnectionOptionsRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceVPCPeeringConnectionOptionsUpdate(d *schema.ResourceData, meta interface{}) error {
-	conn := meta.(*conns.AWSClient).EC2Conn
+// findVPCPeeringConnectionInOtherRegion returns the specified VPC Peering Connection as described in the specified region,
+// or nil if the region is the provider's region or the VPC Peering Connection can't be read there.
+func findVPCPeeringConnectionInOtherRegion(ctx context.Context, meta interface{}, id, region string) *ec2.VpcPeeringConnection {
+	if region == "" || region == meta.(*conns.AWSClient).Region {
+		return nil
+	}
 
-	vpcPeeringConnection, err := FindVPCPeeringConnectionByID(conn, d.Id())
+	conn, err := vpcPeeringConnectionConnForRegion(meta, region)
 
 	if err != nil {
-		return fmt.Errorf("error reading EC2 VPC Peering Connection (%s): %w", d.Id(), err)
-	}
+		log.Printf("[WARN] Unable to read EC2 VPC Peering Connection (%s) in %s: %s", id, region, err)
 
-	if err := modifyVPCPeeringConnectionOptions(conn, d, vpcPeeringConnection, false); err != nil {
-		return err
+		return nil
 	}
 
-	return resourceVPCPeeringConnectionOptionsRead(d, meta)
-}
+	vpcPeeringConnection, err := FindVPCPeeringConnectionByID(ctx, conn, id)
 
-func resourceVPCPeeringConnectionOptionsDelete(d *schema.ResourceData, meta interface{}) error {
-	// Don't do anything with the underlying VPC Peering Connection.
-	return nil
+	if err != nil {
+		log.Printf("[WARN] Unable to read EC2 VPC Peering Connection (%s) in %s: %s", id, region, err)
+
+		return nil
+	}
+
+	return vpcPeeringConnection
 }
@@ -1,10 +1,14 @@
 package ec2_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/google/go-cmp/cmp"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -16,6 +20,68 @@ import (
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 )
 
+func TestResourceVPCPeeringConnectionOptionsImport(t *testing.T) {
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		if got, want := r.Operation.Name, "DescribeVpcPeeringConnections"; got != want {
+			t.Errorf("unexpected operation: %s", got)
+		}
+
+		r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{{
+			AccepterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+				PeeringOptions: &ec2.VpcPeeringConnectionOptionsDescription{
+					AllowDnsResolutionFromRemoteVpc:            aws.Bool(true),
+					AllowEgressFromLocalClassicLinkToRemoteVpc: aws.Bool(false),
+					AllowEgressFromLocalVpcToRemoteClassicLink: aws.Bool(false),
+				},
+				Region: aws.String(endpoints.UsWest2RegionID),
+			},
+			RequesterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+				PeeringOptions: &ec2.VpcPeeringConnectionOptionsDescription{
+					AllowDnsResolutionFromRemoteVpc:            aws.Bool(false),
+					AllowEgressFromLocalClassicLinkToRemoteVpc: aws.Bool(true),
+					AllowEgressFromLocalVpcToRemoteClassicLink: aws.Bool(false),
+				},
+				Region: aws.String(endpoints.UsWest2RegionID),
+			},
+			Status:                 &ec2.VpcPeeringConnectionStateReason{Code: aws.String(ec2.VpcPeeringConnectionStateReasonCodeActive)},
+			VpcPeeringConnectionId: aws.String("pcx-12345678"),
+		}}
+	})
+	meta := &conns.AWSClient{
+		EC2Conn: conn,
+		Region:  endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnectionOptions()
+	d := r.TestResourceData()
+	d.SetId("pcx-12345678")
+
+	results, err := r.Importer.StateContext(context.Background(), d, meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(results), 1; got != want {
+		t.Fatalf("got %d results, expected %d", got, want)
+	}
+
+	for k, want := range map[string]string{
+		"vpc_peering_connection_id": "pcx-12345678",
+		"accepter.#":                "1",
+		"accepter.0.allow_remote_vpc_dns_resolution":  "true",
+		"accepter.0.allow_classic_link_to_remote_vpc": "false",
+		"requester.#": "1",
+		"requester.0.allow_remote_vpc_dns_resolution":  "false",
+		"requester.0.allow_classic_link_to_remote_vpc": "true",
+		"skip_options_wait":                            "false",
+	} {
+		if got := results[0].State().Attributes[k]; got != want {
+			t.Errorf("got %s %q, expected %q", k, got, want)
+		}
+	}
+}
+
 func TestAccVPCPeeringConnectionOptions_basic(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	resourceName := "aws_vpc_peering_connection_options.test"
@@ -267,7 +333,7 @@ func testAccCheckVPCPeeringConnectionOptionsWithProvider(n, block string, option
 
 		conn := providerF().Meta().(*conns.AWSClient).EC2Conn
 
-		output, err := tfec2.FindVPCPeeringConnectionByID(conn, rs.Primary.ID)
+		output, err := tfec2.FindVPCPeeringConnectionByID(context.Background(), conn, rs.Primary.ID)
 
 		if err != nil {
 			return err
@@ -473,3 +539,87 @@ resource "aws_vpc_peering_connection_options" "peer" {
 }
 `, rName))
 }
+
+func TestResourceVPCPeeringConnectionOptionsReadSkipOptionsWait(t *testing.T) {
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		if got, want := r.Operation.Name, "DescribeVpcPeeringConnections"; got != want {
+			t.Errorf("unexpected operation: %s", got)
+		}
+
+		r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{testVPCPeeringConnection("111111111111", ec2.VpcPeeringConnectionStateReasonCodeActive, testVPCPeeringConnectionOptions(false), testVPCPeeringConnectionOptions(false))}
+	})
+	meta := &conns.AWSClient{
+		EC2Conn: conn,
+		Region:  endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnectionOptions()
+	d := r.Data(&terraform.InstanceState{
+		ID: "pcx-12345678",
+		Attributes: map[string]string{
+			"id":                        "pcx-12345678",
+			"skip_options_wait":         "true",
+			"vpc_peering_connection_id": "pcx-12345678",
+		},
+	})
+
+	if diags := r.ReadWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// The configured value is kept, so there's no diff on the next plan.
+	if got := d.Get("skip_options_wait").(bool); !got {
+		t.Errorf("got skip_options_wait %t, expected true", got)
+	}
+}
+
+func TestResourceVPCPeeringConnectionOptionsCreateSkipOptionsWait(t *testing.T) {
+	var modifies int
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		switch r.Operation.Name {
+		case "DescribeVpcPeeringConnections":
+			// The modified options are never reflected.
+			r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{{
+				AccepterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+					PeeringOptions: &ec2.VpcPeeringConnectionOptionsDescription{AllowDnsResolutionFromRemoteVpc: aws.Bool(false)},
+					Region:         aws.String(endpoints.UsWest2RegionID),
+				},
+				RequesterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+					PeeringOptions: &ec2.VpcPeeringConnectionOptionsDescription{AllowDnsResolutionFromRemoteVpc: aws.Bool(false)},
+					Region:         aws.String(endpoints.UsWest2RegionID),
+				},
+				Status:                 &ec2.VpcPeeringConnectionStateReason{Code: aws.String(ec2.VpcPeeringConnectionStateReasonCodeActive)},
+				VpcPeeringConnectionId: aws.String("pcx-12345678"),
+			}}
+		case "ModifyVpcPeeringConnectionOptions":
+			modifies++
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+	meta := &conns.AWSClient{
+		EC2Conn: conn,
+		Region:  endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnectionOptions()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"requester": []interface{}{map[string]interface{}{
+			"allow_remote_vpc_dns_resolution": true,
+		}},
+		"skip_options_wait":         true,
+		"vpc_peering_connection_id": "pcx-12345678",
+	})
+
+	// Waiting for the options to be reflected would exceed the deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if diags := r.CreateWithoutTimeout(ctx, d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := modifies, 1; got != want {
+		t.Errorf("got %d ModifyVpcPeeringConnectionOptions calls, expected %d", got, want)
+	}
+}
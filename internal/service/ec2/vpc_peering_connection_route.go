@@ -0,0 +1,218 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVPCPeeringConnectionRoute() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVPCPeeringConnectionRouteCreate,
+		ReadWithoutTimeout:   resourceVPCPeeringConnectionRouteRead,
+		DeleteWithoutTimeout: resourceVPCPeeringConnectionRouteDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVPCPeeringConnectionRouteImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"destination_cidr_block": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+			},
+			"origin": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpc_peering_connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceVPCPeeringConnectionRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	vpcPeeringConnectionID := d.Get("vpc_peering_connection_id").(string)
+
+	if _, err := findActiveVPCPeeringConnectionForRoutes(ctx, conn, vpcPeeringConnectionID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	routeTableID := d.Get("route_table_id").(string)
+	destination := d.Get("destination_cidr_block").(string)
+
+	if err := createVPCPeeringConnectionRoute(ctx, conn, routeTableID, destination, vpcPeeringConnectionID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(RouteCreateID(routeTableID, destination))
+
+	if err := waitVPCPeeringConnectionRouteReady(conn, routeTableID, destination, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceVPCPeeringConnectionRouteRead(ctx, d, meta)
+}
+
+func resourceVPCPeeringConnectionRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	routeTableID := d.Get("route_table_id").(string)
+	destination := d.Get("destination_cidr_block").(string)
+
+	route, err := FindRouteByIPv4Destination(conn, routeTableID, destination)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route in Route Table (%s) with destination (%s) not found, removing from state", routeTableID, destination)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Route in Route Table (%s) with destination (%s): %s", routeTableID, destination, err)
+	}
+
+	d.Set("destination_cidr_block", route.DestinationCidrBlock)
+	d.Set("origin", route.Origin)
+	d.Set("route_table_id", routeTableID)
+	d.Set("state", route.State)
+	d.Set("vpc_peering_connection_id", route.VpcPeeringConnectionId)
+
+	return nil
+}
+
+func resourceVPCPeeringConnectionRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	if err := deleteVPCPeeringConnectionRoute(ctx, conn, d.Get("route_table_id").(string), d.Get("destination_cidr_block").(string), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceVPCPeeringConnectionRouteImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.Split(d.Id(), "_")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%q), expected ROUTETABLEID_DESTINATION", d.Id())
+	}
+
+	routeTableID := idParts[0]
+	destination := idParts[1]
+	d.Set("destination_cidr_block", destination)
+	d.Set("route_table_id", routeTableID)
+
+	d.SetId(RouteCreateID(routeTableID, destination))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// findActiveVPCPeeringConnectionForRoutes returns the specified VPC Peering Connection.
+// Routes can only target an active VPC Peering Connection, so an error is returned if it isn't active.
+func findActiveVPCPeeringConnectionForRoutes(ctx context.Context, conn *ec2.EC2, vpcPeeringConnectionID string) (*ec2.VpcPeeringConnection, error) {
+	vpcPeeringConnection, err := FindVPCPeeringConnectionByID(ctx, conn, vpcPeeringConnectionID)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading EC2 VPC Peering Connection (%s): %w", vpcPeeringConnectionID, err)
+	}
+
+	if statusCode := aws.StringValue(vpcPeeringConnection.Status.Code); statusCode != ec2.VpcPeeringConnectionStateReasonCodeActive {
+		return nil, fmt.Errorf("EC2 VPC Peering Connection (%s) is not active (current status: %s). Accept the VPC Peering Connection before creating routes that target it", vpcPeeringConnectionID, statusCode)
+	}
+
+	return vpcPeeringConnection, nil
+}
+
+// createVPCPeeringConnectionRoute creates a route to the specified IPv4 destination that targets the specified VPC Peering Connection.
+func createVPCPeeringConnectionRoute(ctx context.Context, conn *ec2.EC2, routeTableID, destination, vpcPeeringConnectionID string, timeout time.Duration) error {
+	input := &ec2.CreateRouteInput{
+		DestinationCidrBlock:   aws.String(destination),
+		RouteTableId:           aws.String(routeTableID),
+		VpcPeeringConnectionId: aws.String(vpcPeeringConnectionID),
+	}
+
+	log.Printf("[DEBUG] Creating Route: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, timeout,
+		func() (interface{}, error) {
+			return conn.CreateRouteWithContext(ctx, input)
+		},
+		errCodeInvalidParameterException,
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating Route in Route Table (%s) with destination (%s): %w", routeTableID, destination, err)
+	}
+
+	return nil
+}
+
+// waitVPCPeeringConnectionRouteReady waits for the route to the specified IPv4 destination to become available.
+func waitVPCPeeringConnectionRouteReady(conn *ec2.EC2, routeTableID, destination string, timeout time.Duration) error {
+	if _, err := WaitRouteReady(conn, FindRouteByIPv4Destination, routeTableID, destination, timeout); err != nil {
+		return fmt.Errorf("error waiting for Route in Route Table (%s) with destination (%s) to become available: %w", routeTableID, destination, err)
+	}
+
+	return nil
+}
+
+// deleteVPCPeeringConnectionRoute deletes the route to the specified IPv4 destination and waits for it to be deleted.
+func deleteVPCPeeringConnectionRoute(ctx context.Context, conn *ec2.EC2, routeTableID, destination string, timeout time.Duration) error {
+	input := &ec2.DeleteRouteInput{
+		DestinationCidrBlock: aws.String(destination),
+		RouteTableId:         aws.String(routeTableID),
+	}
+
+	log.Printf("[DEBUG] Deleting Route: %s", input)
+	_, err := tfresource.RetryWhenAWSErrCodeEqualsContext(ctx, timeout,
+		func() (interface{}, error) {
+			return conn.DeleteRouteWithContext(ctx, input)
+		},
+		errCodeInvalidParameterException,
+	)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Route in Route Table (%s) with destination (%s): %w", routeTableID, destination, err)
+	}
+
+	if _, err := WaitRouteDeleted(conn, FindRouteByIPv4Destination, routeTableID, destination, timeout); err != nil {
+		return fmt.Errorf("error waiting for Route in Route Table (%s) with destination (%s) to delete: %w", routeTableID, destination, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package ec2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccVPCPeeringConnectionRoute_basic(t *testing.T) {
+	var route ec2.Route
+	resourceName := "aws_vpc_peering_connection_route.test"
+	pcxResourceName := "aws_vpc_peering_connection.test"
+	rtResourceName := "aws_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	destinationCidr := "10.0.0.0/16"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionRouteConfig_basic(rName, destinationCidr, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteExists(resourceName, &route),
+					resource.TestCheckResourceAttr(resourceName, "destination_cidr_block", destinationCidr),
+					resource.TestCheckResourceAttr(resourceName, "origin", ec2.RouteOriginCreateRoute),
+					resource.TestCheckResourceAttrPair(resourceName, "route_table_id", rtResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.RouteStateActive),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_peering_connection_id", pcxResourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccRouteImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnectionRoute_disappears(t *testing.T) {
+	var route ec2.Route
+	resourceName := "aws_vpc_peering_connection_route.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	destinationCidr := "10.0.0.0/16"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionRouteConfig_basic(rName, destinationCidr, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRouteExists(resourceName, &route),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVPCPeeringConnectionRoute(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnectionRoute_notActive(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	destinationCidr := "10.0.0.0/16"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCPeeringConnectionRouteConfig_basic(rName, destinationCidr, false),
+				ExpectError: regexp.MustCompile(`is not active \(current status: pending-acceptance\)`),
+			},
+		},
+	})
+}
+
+func testAccCheckVPCPeeringConnectionRouteDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_peering_connection_route" {
+			continue
+		}
+
+		_, err := tfec2.FindRouteByIPv4Destination(conn, rs.Primary.Attributes["route_table_id"], rs.Primary.Attributes["destination_cidr_block"])
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Route still exists")
+	}
+
+	return nil
+}
+
+func testAccVPCPeeringConnectionRouteConfig_basic(rName, destinationCidr string, autoAccept bool) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "target" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.target.id
+  auto_accept = %[3]t
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection_route" "test" {
+  route_table_id            = aws_route_table.test.id
+  destination_cidr_block    = %[2]q
+  vpc_peering_connection_id = aws_vpc_peering_connection.test.id
+}
+`, rName, destinationCidr, autoAccept)
+}
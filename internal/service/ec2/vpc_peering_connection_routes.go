@@ -0,0 +1,322 @@
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceVPCPeeringConnectionRoutes() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceVPCPeeringConnectionRoutesCreate,
+		ReadWithoutTimeout:   resourceVPCPeeringConnectionRoutesRead,
+		UpdateWithoutTimeout: resourceVPCPeeringConnectionRoutesUpdate,
+		DeleteWithoutTimeout: resourceVPCPeeringConnectionRoutesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceVPCPeeringConnectionRoutesImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accepter_destination_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+				},
+			},
+			"accepter_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"accepter_route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"requester_destination_cidr_blocks": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+				},
+			},
+			"requester_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"requester_route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpc_peering_connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceVPCPeeringConnectionRoutesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	vpcPeeringConnectionID := d.Get("vpc_peering_connection_id").(string)
+	vpcPeeringConnection, err := findActiveVPCPeeringConnectionForRoutes(ctx, conn, vpcPeeringConnectionID)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	requesterConn, accepterConn, err := vpcPeeringConnectionRoutesConns(meta, vpcPeeringConnection)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	requesterRouteTableID := d.Get("requester_route_table_id").(string)
+	accepterRouteTableID := d.Get("accepter_route_table_id").(string)
+
+	// The ID is set before any routes are created so that a partially created set of routes is tracked.
+	d.SetId(VPCPeeringConnectionRoutesCreateID(vpcPeeringConnectionID, requesterRouteTableID, accepterRouteTableID))
+
+	if err := createVPCPeeringConnectionRoutes(ctx, requesterConn, requesterRouteTableID, flex.ExpandStringValueSet(d.Get("requester_destination_cidr_blocks").(*schema.Set)), vpcPeeringConnectionID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := createVPCPeeringConnectionRoutes(ctx, accepterConn, accepterRouteTableID, flex.ExpandStringValueSet(d.Get("accepter_destination_cidr_blocks").(*schema.Set)), vpcPeeringConnectionID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceVPCPeeringConnectionRoutesRead(ctx, d, meta)
+}
+
+func resourceVPCPeeringConnectionRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	vpcPeeringConnectionID, requesterRouteTableID, accepterRouteTableID, err := VPCPeeringConnectionRoutesParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vpcPeeringConnection, err := FindVPCPeeringConnectionByID(ctx, conn, vpcPeeringConnectionID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 VPC Peering Connection (%s) not found, removing Routes from state", vpcPeeringConnectionID)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading EC2 VPC Peering Connection (%s): %s", vpcPeeringConnectionID, err)
+	}
+
+	requesterConn, accepterConn, err := vpcPeeringConnectionRoutesConns(meta, vpcPeeringConnection)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	requesterDestinations, err := findVPCPeeringConnectionRouteDestinations(requesterConn, requesterRouteTableID, vpcPeeringConnectionID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route Table (%s) not found, removing EC2 VPC Peering Connection (%s) Routes from state", requesterRouteTableID, vpcPeeringConnectionID)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading EC2 VPC Peering Connection (%s) Routes in Route Table (%s): %s", vpcPeeringConnectionID, requesterRouteTableID, err)
+	}
+
+	accepterDestinations, err := findVPCPeeringConnectionRouteDestinations(accepterConn, accepterRouteTableID, vpcPeeringConnectionID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route Table (%s) not found, removing EC2 VPC Peering Connection (%s) Routes from state", accepterRouteTableID, vpcPeeringConnectionID)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading EC2 VPC Peering Connection (%s) Routes in Route Table (%s): %s", vpcPeeringConnectionID, accepterRouteTableID, err)
+	}
+
+	d.Set("accepter_destination_cidr_blocks", accepterDestinations)
+	d.Set("accepter_region", vpcPeeringConnection.AccepterVpcInfo.Region)
+	d.Set("accepter_route_table_id", accepterRouteTableID)
+	d.Set("requester_destination_cidr_blocks", requesterDestinations)
+	d.Set("requester_region", vpcPeeringConnection.RequesterVpcInfo.Region)
+	d.Set("requester_route_table_id", requesterRouteTableID)
+	d.Set("vpc_peering_connection_id", vpcPeeringConnectionID)
+
+	return nil
+}
+
+func resourceVPCPeeringConnectionRoutesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	vpcPeeringConnectionID := d.Get("vpc_peering_connection_id").(string)
+	vpcPeeringConnection, err := findActiveVPCPeeringConnectionForRoutes(ctx, conn, vpcPeeringConnectionID)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	requesterConn, accepterConn, err := vpcPeeringConnectionRoutesConns(meta, vpcPeeringConnection)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, side := range []struct {
+		conn *ec2.EC2
+		key  string
+	}{
+		{conn: requesterConn, key: "requester"},
+		{conn: accepterConn, key: "accepter"},
+	} {
+		key := side.key + "_destination_cidr_blocks"
+
+		if !d.HasChange(key) {
+			continue
+		}
+
+		routeTableID := d.Get(side.key + "_route_table_id").(string)
+		o, n := d.GetChange(key)
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		for _, destination := range flex.ExpandStringValueSet(os.Difference(ns)) {
+			if err := deleteVPCPeeringConnectionRoute(ctx, side.conn, routeTableID, destination, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if err := createVPCPeeringConnectionRoutes(ctx, side.conn, routeTableID, flex.ExpandStringValueSet(ns.Difference(os)), vpcPeeringConnectionID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceVPCPeeringConnectionRoutesRead(ctx, d, meta)
+}
+
+func resourceVPCPeeringConnectionRoutesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The routes are deleted using only the route tables, destinations and regions in state
+	// so that they're cleaned up even if the VPC Peering Connection no longer exists.
+	for _, key := range []string{"requester", "accepter"} {
+		conn, err := vpcPeeringConnectionConnForRegion(meta, d.Get(key+"_region").(string))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		routeTableID := d.Get(key + "_route_table_id").(string)
+
+		for _, destination := range flex.ExpandStringValueSet(d.Get(key + "_destination_cidr_blocks").(*schema.Set)) {
+			err := deleteVPCPeeringConnectionRoute(ctx, conn, routeTableID, destination, d.Timeout(schema.TimeoutDelete))
+
+			if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteTableIDNotFound, errCodeInvalidRouteTableIdNotFound) {
+				break
+			}
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceVPCPeeringConnectionRoutesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	vpcPeeringConnectionID, requesterRouteTableID, accepterRouteTableID, err := VPCPeeringConnectionRoutesParseID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("accepter_route_table_id", accepterRouteTableID)
+	d.Set("requester_route_table_id", requesterRouteTableID)
+	d.Set("vpc_peering_connection_id", vpcPeeringConnectionID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// vpcPeeringConnectionRoutesConns returns EC2 clients for the requester's and accepter's regions of the specified VPC Peering Connection.
+// Each side's route table is in that side's region.
+func vpcPeeringConnectionRoutesConns(meta interface{}, vpcPeeringConnection *ec2.VpcPeeringConnection) (*ec2.EC2, *ec2.EC2, error) {
+	requesterConn, err := vpcPeeringConnectionRequesterConn(meta, vpcPeeringConnection)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	accepterConn, err := vpcPeeringConnectionConnForRegion(meta, aws.StringValue(vpcPeeringConnection.AccepterVpcInfo.Region))
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return requesterConn, accepterConn, nil
+}
+
+// createVPCPeeringConnectionRoutes creates routes to the specified IPv4 destinations that target the specified VPC Peering Connection
+// and waits for them to become available.
+func createVPCPeeringConnectionRoutes(ctx context.Context, conn *ec2.EC2, routeTableID string, destinations []string, vpcPeeringConnectionID string, timeout time.Duration) error {
+	for _, destination := range destinations {
+		if err := createVPCPeeringConnectionRoute(ctx, conn, routeTableID, destination, vpcPeeringConnectionID, timeout); err != nil {
+			return err
+		}
+
+		if err := waitVPCPeeringConnectionRouteReady(conn, routeTableID, destination, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findVPCPeeringConnectionRouteDestinations returns the IPv4 destinations of the routes in the specified route table
+// that target the specified VPC Peering Connection.
+func findVPCPeeringConnectionRouteDestinations(conn *ec2.EC2, routeTableID, vpcPeeringConnectionID string) ([]string, error) {
+	routeTable, err := FindRouteTableByID(conn, routeTableID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var destinations []string
+
+	for _, route := range routeTable.Routes {
+		if route == nil || aws.StringValue(route.VpcPeeringConnectionId) != vpcPeeringConnectionID {
+			continue
+		}
+
+		if v := aws.StringValue(route.DestinationCidrBlock); v != "" {
+			destinations = append(destinations, v)
+		}
+	}
+
+	return destinations, nil
+}
@@ -0,0 +1,101 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceVPCPeeringConnectionRoutes() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceVPCPeeringConnectionRoutesRead,
+
+		Schema: map[string]*schema.Schema{
+			"routes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destination_ipv6_cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"destination_prefix_list_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"route_table_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"vpc_peering_connection_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceVPCPeeringConnectionRoutesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	vpcPeeringConnectionID := d.Get("vpc_peering_connection_id").(string)
+	input := &ec2.DescribeRouteTablesInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"route.vpc-peering-connection-id": vpcPeeringConnectionID,
+		}),
+	}
+
+	output, err := FindRouteTables(conn, input)
+
+	if err != nil {
+		return diag.Errorf("error reading EC2 Route Tables for EC2 VPC Peering Connection (%s): %s", vpcPeeringConnectionID, err)
+	}
+
+	var routes []interface{}
+
+	for _, routeTable := range output {
+		for _, route := range routeTable.Routes {
+			// A route table can contain routes with other targets.
+			if route == nil || aws.StringValue(route.VpcPeeringConnectionId) != vpcPeeringConnectionID {
+				continue
+			}
+
+			routes = append(routes, map[string]interface{}{
+				"destination_cidr_block":      aws.StringValue(route.DestinationCidrBlock),
+				"destination_ipv6_cidr_block": aws.StringValue(route.DestinationIpv6CidrBlock),
+				"destination_prefix_list_id":  aws.StringValue(route.DestinationPrefixListId),
+				"route_table_id":              aws.StringValue(routeTable.RouteTableId),
+				"state":                       aws.StringValue(route.State),
+				"vpc_id":                      aws.StringValue(routeTable.VpcId),
+			})
+		}
+	}
+
+	d.SetId(vpcPeeringConnectionID)
+
+	if err := d.Set("routes", routes); err != nil {
+		return diag.Errorf("error setting routes: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestDataSourceVPCPeeringConnectionRoutesRead(t *testing.T) {
+	var inputs []*ec2.DescribeRouteTablesInput
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		if got, want := r.Operation.Name, "DescribeRouteTables"; got != want {
+			t.Errorf("unexpected operation: %s", got)
+		}
+
+		inputs = append(inputs, r.Params.(*ec2.DescribeRouteTablesInput))
+
+		r.Data.(*ec2.DescribeRouteTablesOutput).RouteTables = []*ec2.RouteTable{
+			{
+				RouteTableId: aws.String("rtb-11111111"),
+				Routes: []*ec2.Route{
+					{
+						DestinationCidrBlock: aws.String("10.1.0.0/16"),
+						GatewayId:            aws.String("local"),
+						State:                aws.String(ec2.RouteStateActive),
+					},
+					{
+						DestinationCidrBlock:   aws.String("10.2.0.0/16"),
+						State:                  aws.String(ec2.RouteStateActive),
+						VpcPeeringConnectionId: aws.String("pcx-12345678"),
+					},
+				},
+				VpcId: aws.String("vpc-11111111"),
+			},
+			{
+				RouteTableId: aws.String("rtb-22222222"),
+				Routes: []*ec2.Route{
+					{
+						DestinationIpv6CidrBlock: aws.String("2001:db8::/56"),
+						State:                    aws.String(ec2.RouteStateBlackhole),
+						VpcPeeringConnectionId:   aws.String("pcx-12345678"),
+					},
+				},
+				VpcId: aws.String("vpc-22222222"),
+			},
+		}
+	})
+
+	ds := tfec2.DataSourceVPCPeeringConnectionRoutes()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"vpc_peering_connection_id": "pcx-12345678",
+	})
+
+	if diags := ds.ReadWithoutTimeout(context.Background(), d, &conns.AWSClient{EC2Conn: conn}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// Route tables are filtered server-side on the VPC Peering Connection ID.
+	if got, want := len(inputs), 1; got != want {
+		t.Fatalf("got %d DescribeRouteTables calls, expected %d", got, want)
+	}
+
+	if filters := inputs[0].Filters; len(filters) != 1 || aws.StringValue(filters[0].Name) != "route.vpc-peering-connection-id" || aws.StringValue(filters[0].Values[0]) != "pcx-12345678" {
+		t.Errorf("unexpected filters: %s", filters)
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"destination_cidr_block":      "10.2.0.0/16",
+			"destination_ipv6_cidr_block": "",
+			"destination_prefix_list_id":  "",
+			"route_table_id":              "rtb-11111111",
+			"state":                       ec2.RouteStateActive,
+			"vpc_id":                      "vpc-11111111",
+		},
+		map[string]interface{}{
+			"destination_cidr_block":      "",
+			"destination_ipv6_cidr_block": "2001:db8::/56",
+			"destination_prefix_list_id":  "",
+			"route_table_id":              "rtb-22222222",
+			"state":                       ec2.RouteStateBlackhole,
+			"vpc_id":                      "vpc-22222222",
+		},
+	}
+
+	if got := d.Get("routes").([]interface{}); !reflect.DeepEqual(got, expected) {
+		t.Errorf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", expected, got)
+	}
+}
+
+func TestAccVPCPeeringConnectionRoutesDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_vpc_peering_connection_routes.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionRoutesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "routes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "routes.0.destination_cidr_block", "10.2.0.0/16"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "routes.0.route_table_id", "aws_route_table.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "routes.0.state", ec2.RouteStateActive),
+					resource.TestCheckResourceAttrPair(dataSourceName, "routes.0.vpc_id", "aws_vpc.requester", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCPeeringConnectionRoutesDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "requester" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "accepter" {
+  cidr_block = "10.2.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.requester.id
+  peer_vpc_id = aws_vpc.accepter.id
+  auto_accept = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.requester.id
+
+  route {
+    cidr_block                = aws_vpc.accepter.cidr_block
+    vpc_peering_connection_id = aws_vpc_peering_connection.test.id
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_vpc_peering_connection_routes" "test" {
+  vpc_peering_connection_id = aws_vpc_peering_connection.test.id
+
+  depends_on = [aws_route_table.test]
+}
+`, rName)
+}
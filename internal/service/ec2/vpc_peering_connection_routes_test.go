@@ -0,0 +1,335 @@
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestResourceVPCPeeringConnectionRoutesUpdate(t *testing.T) {
+	// Route table ID -> destinations of the routes that target the VPC Peering Connection.
+	routeTables := map[string][]string{
+		"rtb-11111111": {"10.2.0.0/16", "10.3.0.0/16"},
+		"rtb-22222222": {"10.1.0.0/16"},
+	}
+
+	var createRoutes, deleteRoutes []string
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		switch r.Operation.Name {
+		case "CreateRoute":
+			input := r.Params.(*ec2.CreateRouteInput)
+			routeTableID, destination := aws.StringValue(input.RouteTableId), aws.StringValue(input.DestinationCidrBlock)
+			createRoutes = append(createRoutes, routeTableID+" "+destination)
+			routeTables[routeTableID] = append(routeTables[routeTableID], destination)
+		case "DeleteRoute":
+			input := r.Params.(*ec2.DeleteRouteInput)
+			routeTableID, destination := aws.StringValue(input.RouteTableId), aws.StringValue(input.DestinationCidrBlock)
+			deleteRoutes = append(deleteRoutes, routeTableID+" "+destination)
+
+			var destinations []string
+			for _, v := range routeTables[routeTableID] {
+				if v != destination {
+					destinations = append(destinations, v)
+				}
+			}
+			routeTables[routeTableID] = destinations
+		case "DescribeRouteTables":
+			routeTableID := aws.StringValue(r.Params.(*ec2.DescribeRouteTablesInput).RouteTableIds[0])
+			routeTable := &ec2.RouteTable{
+				RouteTableId: aws.String(routeTableID),
+				Routes: []*ec2.Route{{
+					DestinationCidrBlock: aws.String("10.0.0.0/8"),
+					GatewayId:            aws.String("local"),
+					State:                aws.String(ec2.RouteStateActive),
+				}},
+			}
+
+			for _, destination := range routeTables[routeTableID] {
+				routeTable.Routes = append(routeTable.Routes, &ec2.Route{
+					DestinationCidrBlock:   aws.String(destination),
+					State:                  aws.String(ec2.RouteStateActive),
+					VpcPeeringConnectionId: aws.String("pcx-12345678"),
+				})
+			}
+
+			r.Data.(*ec2.DescribeRouteTablesOutput).RouteTables = []*ec2.RouteTable{routeTable}
+		case "DescribeVpcPeeringConnections":
+			r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{{
+				AccepterVpcInfo:        &ec2.VpcPeeringConnectionVpcInfo{Region: aws.String(endpoints.UsWest2RegionID)},
+				RequesterVpcInfo:       &ec2.VpcPeeringConnectionVpcInfo{Region: aws.String(endpoints.UsWest2RegionID)},
+				Status:                 &ec2.VpcPeeringConnectionStateReason{Code: aws.String(ec2.VpcPeeringConnectionStateReasonCodeActive)},
+				VpcPeeringConnectionId: aws.String("pcx-12345678"),
+			}}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+	conn.Config.Region = aws.String(endpoints.UsWest2RegionID)
+	meta := &conns.AWSClient{
+		EC2Conn: conn,
+		Region:  endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnectionRoutes()
+	old := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"accepter_destination_cidr_blocks":  []interface{}{"10.1.0.0/16"},
+		"accepter_route_table_id":           "rtb-22222222",
+		"requester_destination_cidr_blocks": []interface{}{"10.2.0.0/16", "10.3.0.0/16"},
+		"requester_route_table_id":          "rtb-11111111",
+		"vpc_peering_connection_id":         "pcx-12345678",
+	})
+	old.SetId(tfec2.VPCPeeringConnectionRoutesCreateID("pcx-12345678", "rtb-11111111", "rtb-22222222"))
+	state := old.State()
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"accepter_destination_cidr_blocks":  []interface{}{"10.1.0.0/16"},
+		"accepter_route_table_id":           "rtb-22222222",
+		"requester_destination_cidr_blocks": []interface{}{"10.2.0.0/16", "10.4.0.0/16"},
+		"requester_route_table_id":          "rtb-11111111",
+		"vpc_peering_connection_id":         "pcx-12345678",
+	}), meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error diffing: %s", err)
+	}
+
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diags := r.UpdateWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// Only the changed routes are replaced.
+	if got, want := deleteRoutes, []string{"rtb-11111111 10.3.0.0/16"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got deleted routes %v, expected %v", got, want)
+	}
+
+	if got, want := createRoutes, []string{"rtb-11111111 10.4.0.0/16"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got created routes %v, expected %v", got, want)
+	}
+
+	got := flex.ExpandStringValueSet(d.Get("requester_destination_cidr_blocks").(*schema.Set))
+	sort.Strings(got)
+
+	if want := []string{"10.2.0.0/16", "10.4.0.0/16"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got requester_destination_cidr_blocks %v, expected %v", got, want)
+	}
+}
+
+func TestResourceVPCPeeringConnectionRoutesDeleteVPCPeeringConnectionNotFound(t *testing.T) {
+	// Route table ID -> destinations of the routes that target the VPC Peering Connection.
+	// The accepter's route table has already been deleted.
+	routeTables := map[string][]string{
+		"rtb-11111111": {"10.2.0.0/16"},
+	}
+
+	var deleteRoutes []string
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		switch r.Operation.Name {
+		case "DeleteRoute":
+			input := r.Params.(*ec2.DeleteRouteInput)
+			routeTableID, destination := aws.StringValue(input.RouteTableId), aws.StringValue(input.DestinationCidrBlock)
+			deleteRoutes = append(deleteRoutes, routeTableID+" "+destination)
+
+			if _, ok := routeTables[routeTableID]; !ok {
+				r.Error = awserr.New("InvalidRouteTableID.NotFound", fmt.Sprintf("The routeTable ID '%s' does not exist", routeTableID), nil)
+				return
+			}
+
+			delete(routeTables, routeTableID)
+		case "DescribeRouteTables":
+			routeTableID := aws.StringValue(r.Params.(*ec2.DescribeRouteTablesInput).RouteTableIds[0])
+			r.Data.(*ec2.DescribeRouteTablesOutput).RouteTables = []*ec2.RouteTable{{
+				RouteTableId: aws.String(routeTableID),
+			}}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+	conn.Config.Region = aws.String(endpoints.UsWest2RegionID)
+	meta := &conns.AWSClient{
+		EC2Conn: conn,
+		Region:  endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnectionRoutes()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"accepter_destination_cidr_blocks":  []interface{}{"10.1.0.0/16"},
+		"accepter_route_table_id":           "rtb-22222222",
+		"requester_destination_cidr_blocks": []interface{}{"10.2.0.0/16"},
+		"requester_route_table_id":          "rtb-11111111",
+		"vpc_peering_connection_id":         "pcx-12345678",
+	})
+	d.SetId(tfec2.VPCPeeringConnectionRoutesCreateID("pcx-12345678", "rtb-11111111", "rtb-22222222"))
+	d.Set("accepter_region", endpoints.UsWest2RegionID)
+	d.Set("requester_region", endpoints.UsWest2RegionID)
+
+	if diags := r.DeleteWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// The routes are deleted without looking up the VPC Peering Connection.
+	if got, want := deleteRoutes, []string{"rtb-11111111 10.2.0.0/16", "rtb-22222222 10.1.0.0/16"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got deleted routes %v, expected %v", got, want)
+	}
+}
+
+func TestAccVPCPeeringConnectionRoutes_basic(t *testing.T) {
+	resourceName := "aws_vpc_peering_connection_routes.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionRoutesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionRoutesConfig_basic(rName, []string{"10.2.0.0/16"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "accepter_destination_cidr_blocks.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "accepter_destination_cidr_blocks.*", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "accepter_region", acctest.Region()),
+					resource.TestCheckResourceAttrPair(resourceName, "accepter_route_table_id", "aws_route_table.accepter", "id"),
+					resource.TestCheckResourceAttr(resourceName, "requester_destination_cidr_blocks.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "requester_destination_cidr_blocks.*", "10.2.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "requester_region", acctest.Region()),
+					resource.TestCheckResourceAttrPair(resourceName, "requester_route_table_id", "aws_route_table.requester", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_peering_connection_id", "aws_vpc_peering_connection.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCPeeringConnectionRoutesConfig_basic(rName, []string{"10.2.0.0/17", "10.2.128.0/17"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "accepter_destination_cidr_blocks.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "requester_destination_cidr_blocks.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "requester_destination_cidr_blocks.*", "10.2.0.0/17"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "requester_destination_cidr_blocks.*", "10.2.128.0/17"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPCPeeringConnectionRoutesDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_peering_connection_routes" {
+			continue
+		}
+
+		for _, side := range []string{"accepter", "requester"} {
+			routeTableID := rs.Primary.Attributes[side+"_route_table_id"]
+
+			for key, destination := range rs.Primary.Attributes {
+				if !strings.HasPrefix(key, side+"_destination_cidr_blocks.") || strings.HasSuffix(key, ".#") {
+					continue
+				}
+
+				_, err := tfec2.FindRouteByIPv4Destination(conn, routeTableID, destination)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("Route in Route Table (%s) with destination (%s) still exists", routeTableID, destination)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccVPCPeeringConnectionRoutesConfig_basic(rName string, requesterDestinations []string) string {
+	var quotedRequesterDestinations []string
+	for _, v := range requesterDestinations {
+		quotedRequesterDestinations = append(quotedRequesterDestinations, strconv.Quote(v))
+	}
+
+	return fmt.Sprintf(`
+resource "aws_vpc" "requester" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "accepter" {
+  cidr_block = "10.2.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.requester.id
+  peer_vpc_id = aws_vpc.accepter.id
+  auto_accept = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "requester" {
+  vpc_id = aws_vpc.requester.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route_table" "accepter" {
+  vpc_id = aws_vpc.accepter.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection_routes" "test" {
+  vpc_peering_connection_id = aws_vpc_peering_connection.test.id
+
+  requester_route_table_id          = aws_route_table.requester.id
+  requester_destination_cidr_blocks = [%[2]s]
+
+  accepter_route_table_id          = aws_route_table.accepter.id
+  accepter_destination_cidr_blocks = [aws_vpc.requester.cidr_block]
+}
+`, rName, strings.Join(quotedRequesterDestinations, ", "))
+}
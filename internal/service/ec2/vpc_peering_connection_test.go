@@ -1,13 +1,29 @@
 package ec2_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/sts"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,458 +34,4562 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-func TestAccVPCPeeringConnection_basic(t *testing.T) {
-	var v ec2.VpcPeeringConnection
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_vpc_peering_connection.test"
+func TestFindVPCPeeringConnectionByID(t *testing.T) {
+	testCases := []struct {
+		statusCode    string
+		expectedFound bool
+	}{
+		{statusCode: ec2.VpcPeeringConnectionStateReasonCodeActive, expectedFound: true},
+		{statusCode: ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, expectedFound: true},
+		{statusCode: ec2.VpcPeeringConnectionStateReasonCodeProvisioning, expectedFound: true},
+		{statusCode: ec2.VpcPeeringConnectionStateReasonCodeDeleted, expectedFound: false},
+		{statusCode: ec2.VpcPeeringConnectionStateReasonCodeExpired, expectedFound: false},
+		{statusCode: ec2.VpcPeeringConnectionStateReasonCodeFailed, expectedFound: false},
+		{statusCode: ec2.VpcPeeringConnectionStateReasonCodeRejected, expectedFound: false},
+	}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccVPCPeeringConnectionConfig_basic(rName),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
-				),
-			},
-			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{
-					"auto_accept",
-				},
-			},
-		},
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.statusCode, func(t *testing.T) {
+			conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+				r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{{
+					Status:                 &ec2.VpcPeeringConnectionStateReason{Code: aws.String(testCase.statusCode)},
+					VpcPeeringConnectionId: aws.String("pcx-12345678"),
+				}}
+			})
+
+			_, err := tfec2.FindVPCPeeringConnectionByID(context.Background(), conn, "pcx-12345678")
+
+			if testCase.expectedFound && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			if !testCase.expectedFound && !tfresource.NotFound(err) {
+				t.Errorf("expected NotFoundError, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestResourceVPCPeeringConnectionReadThrottled(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("error parsing request: %s", err)
+		}
+
+		if got, want := r.Form.Get("Action"), "DescribeVpcPeeringConnections"; got != want {
+			t.Errorf("unexpected action: %s", got)
+		}
+
+		// Throttled describes are retried by the provider's EC2 client.
+		if requests++; requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `<Response><Errors><Error><Code>RequestLimitExceeded</Code><Message>Request limit exceeded.</Message></Error></Errors><RequestID>1</RequestID></Response>`)
+
+			return
+		}
+
+		fmt.Fprint(w, testVPCPeeringConnectionEndpointDescribeResponse(ec2.VpcPeeringConnectionStateReasonCodeActive))
+	}))
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKIAREQUESTER", "secret", ""),
+		Endpoint:    aws.String(server.URL),
+		MaxRetries:  aws.Int(1),
+		Region:      aws.String(endpoints.UsWest2RegionID),
 	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	meta := &conns.AWSClient{
+		EC2Conn: ec2.New(sess),
+		Region:  endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnection()
+	d := r.TestResourceData()
+	d.SetId("pcx-12345678")
+
+	if diags := r.ReadWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() == "" {
+		t.Fatal("expected VPC Peering Connection to remain in state")
+	}
+
+	if got, want := d.Get("accept_status").(string), ec2.VpcPeeringConnectionStateReasonCodeActive; got != want {
+		t.Errorf("got accept_status %q, expected %q", got, want)
+	}
+
+	if got, want := requests, 2; got != want {
+		t.Errorf("got %d requests to the EC2 endpoint, expected %d", got, want)
+	}
 }
 
-func TestAccVPCPeeringConnection_disappears(t *testing.T) {
-	var v ec2.VpcPeeringConnection
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_vpc_peering_connection.test"
+func TestFindVPCPeeringConnectionByIDEndpoint(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccVPCPeeringConnectionConfig_basic(rName),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
-					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVPCPeeringConnection(), resourceName),
-				),
-				ExpectNonEmptyPlan: true,
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("error parsing request: %s", err)
+		}
+
+		if got, want := r.Form.Get("Action"), "DescribeVpcPeeringConnections"; got != want {
+			t.Errorf("unexpected action: %s", got)
+		}
+
+		fmt.Fprint(w, testVPCPeeringConnectionEndpointDescribeResponse(ec2.VpcPeeringConnectionStateReasonCodeActive))
+	}))
+	defer server.Close()
+
+	meta := &conns.AWSClient{
+		EC2Conn: testVPCPeeringConnectionEndpointConn(t, server.URL),
+		Region:  endpoints.UsWest2RegionID,
+	}
+
+	// Clients for other regions, e.g. for the other side of a cross-region VPC Peering Connection, use the same endpoint.
+	for _, region := range []string{endpoints.UsWest2RegionID, endpoints.UsEast1RegionID} {
+		conn, err := meta.EC2ConnForRegion(region)
+
+		if err != nil {
+			t.Fatalf("error creating EC2 client for %s: %s", region, err)
+		}
+
+		if _, err := tfec2.FindVPCPeeringConnectionByID(context.Background(), conn, "pcx-12345678"); err != nil {
+			t.Errorf("unexpected error in %s: %s", region, err)
+		}
+	}
+
+	if got, want := requests, 2; got != want {
+		t.Errorf("got %d requests to the EC2 endpoint, expected %d", got, want)
+	}
+}
+
+func TestFindVPCPeeringConnectionByIDAndStatus(t *testing.T) {
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{{
+			Status:                 &ec2.VpcPeeringConnectionStateReason{Code: aws.String(ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance)},
+			VpcPeeringConnectionId: aws.String("pcx-12345678"),
+		}}
+	})
+
+	if _, err := tfec2.FindVPCPeeringConnectionByIDAndStatus(context.Background(), conn, "pcx-12345678", ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if _, err := tfec2.FindVPCPeeringConnectionByIDAndStatus(context.Background(), conn, "pcx-12345678", ec2.VpcPeeringConnectionStateReasonCodeActive); !tfresource.NotFound(err) {
+		t.Errorf("expected NotFoundError, got: %v", err)
+	}
+}
+
+func TestFindVPCPeeringConnections(t *testing.T) {
+	pages := map[string]*ec2.DescribeVpcPeeringConnectionsOutput{
+		"": {
+			NextToken: aws.String("page2"),
+			VpcPeeringConnections: []*ec2.VpcPeeringConnection{
+				{Status: &ec2.VpcPeeringConnectionStateReason{Code: aws.String(ec2.VpcPeeringConnectionStateReasonCodeActive)}, VpcPeeringConnectionId: aws.String("pcx-11111111")},
+				{Status: &ec2.VpcPeeringConnectionStateReason{Code: aws.String(ec2.VpcPeeringConnectionStateReasonCodeActive)}, VpcPeeringConnectionId: aws.String("pcx-22222222")},
+			},
+		},
+		"page2": {
+			VpcPeeringConnections: []*ec2.VpcPeeringConnection{
+				{Status: &ec2.VpcPeeringConnectionStateReason{Code: aws.String(ec2.VpcPeeringConnectionStateReasonCodeActive)}, VpcPeeringConnectionId: aws.String("pcx-33333333")},
 			},
 		},
+	}
+
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		page := pages[aws.StringValue(r.Params.(*ec2.DescribeVpcPeeringConnectionsInput).NextToken)]
+		output := r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput)
+		output.NextToken = page.NextToken
+		output.VpcPeeringConnections = page.VpcPeeringConnections
 	})
+
+	output, err := tfec2.FindVPCPeeringConnections(context.Background(), conn, &ec2.DescribeVpcPeeringConnectionsInput{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := len(output), 3; got != want {
+		t.Errorf("got %d VPC Peering Connections, want %d", got, want)
+	}
+
+	_, err = tfec2.FindVPCPeeringConnection(context.Background(), conn, &ec2.DescribeVpcPeeringConnectionsInput{})
+
+	if !errors.Is(err, tfresource.ErrTooManyResults) {
+		t.Errorf("expected TooManyResultsError, got: %v", err)
+	}
 }
 
-func TestAccVPCPeeringConnection_tags(t *testing.T) {
-	var v ec2.VpcPeeringConnection
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_vpc_peering_connection.test"
+func TestStatusVPCPeeringConnectionDeleted(t *testing.T) {
+	testCases := map[string]struct {
+		describeErr        error
+		statusCode         string
+		expectedStatusCode string
+		expectedFound      bool
+	}{
+		"active": {
+			statusCode:         ec2.VpcPeeringConnectionStateReasonCodeActive,
+			expectedStatusCode: ec2.VpcPeeringConnectionStateReasonCodeActive,
+			expectedFound:      true,
+		},
+		"deleting": {
+			statusCode:         ec2.VpcPeeringConnectionStateReasonCodeDeleting,
+			expectedStatusCode: ec2.VpcPeeringConnectionStateReasonCodeDeleting,
+			expectedFound:      true,
+		},
+		"deleted": {
+			statusCode: ec2.VpcPeeringConnectionStateReasonCodeDeleted,
+		},
+		"not found": {
+			describeErr: awserr.New("InvalidVpcPeeringConnectionID.NotFound", "The vpcPeeringConnection ID 'pcx-12345678' does not exist", nil),
+		},
+	}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckVPCDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccVPCPeeringConnectionConfig_tags1(rName, "key1", "value1"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
-				),
-			},
-			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{
-					"auto_accept",
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			conn := &testVPCPeeringConnectionStubConn{
+				describe: func(input *ec2.DescribeVpcPeeringConnectionsInput) (*ec2.DescribeVpcPeeringConnectionsOutput, error) {
+					if testCase.describeErr != nil {
+						return nil, testCase.describeErr
+					}
+
+					return &ec2.DescribeVpcPeeringConnectionsOutput{
+						VpcPeeringConnections: []*ec2.VpcPeeringConnection{{
+							Status:                 &ec2.VpcPeeringConnectionStateReason{Code: aws.String(testCase.statusCode)},
+							VpcPeeringConnectionId: aws.String("pcx-12345678"),
+						}},
+					}, nil
 				},
-			},
-			{
-				Config: testAccVPCPeeringConnectionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
-				),
-			},
-			{
-				Config: testAccVPCPeeringConnectionConfig_tags1(rName, "key2", "value2"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
-				),
-			},
+			}
+
+			output, statusCode, err := tfec2.StatusVPCPeeringConnectionDeleted(context.Background(), conn, "pcx-12345678")()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := statusCode, testCase.expectedStatusCode; got != want {
+				t.Errorf("got status code %q, expected %q", got, want)
+			}
+
+			if got, want := output != nil, testCase.expectedFound; got != want {
+				t.Errorf("got found %t, expected %t", got, want)
+			}
+		})
+	}
+}
+
+func TestWaitVPCPeeringConnectionEmptyStatus(t *testing.T) {
+	// A newly created or deleted VPC Peering Connection can briefly be described without a status code.
+	testCases := map[string]struct {
+		statusCodes []string
+		wait        func(context.Context, ec2iface.EC2API, string, time.Duration) (*ec2.VpcPeeringConnection, error)
+	}{
+		"active": {
+			statusCodes: []string{"", ec2.VpcPeeringConnectionStateReasonCodeActive},
+			wait:        tfec2.WaitVPCPeeringConnectionActive,
+		},
+		"accepted": {
+			statusCodes: []string{"", ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, ec2.VpcPeeringConnectionStateReasonCodeActive},
+			wait:        tfec2.WaitVPCPeeringConnectionAccepted,
 		},
+		"deleted": {
+			statusCodes: []string{"", ec2.VpcPeeringConnectionStateReasonCodeDeleted},
+			wait:        tfec2.WaitVPCPeeringConnectionDeleted,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var describes int
+
+			conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+				statusCode := testCase.statusCodes[len(testCase.statusCodes)-1]
+				if describes < len(testCase.statusCodes) {
+					statusCode = testCase.statusCodes[describes]
+				}
+				describes++
+
+				r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{{
+					Status:                 &ec2.VpcPeeringConnectionStateReason{Code: aws.String(statusCode)},
+					VpcPeeringConnectionId: aws.String("pcx-12345678"),
+				}}
+			})
+
+			if _, err := testCase.wait(context.Background(), conn, "pcx-12345678", 1*time.Minute); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := describes, len(testCase.statusCodes); got != want {
+				t.Errorf("got %d describes, expected %d", got, want)
+			}
+		})
+	}
+}
+
+func TestWaitVPCPeeringConnectionDeletedNotFound(t *testing.T) {
+	// In some regions a deleted VPC Peering Connection disappears rather than being described as deleted.
+	var describes int
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		describes++
+
+		if describes == 1 {
+			r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{{
+				Status:                 &ec2.VpcPeeringConnectionStateReason{Code: aws.String(ec2.VpcPeeringConnectionStateReasonCodeDeleting)},
+				VpcPeeringConnectionId: aws.String("pcx-12345678"),
+			}}
+
+			return
+		}
+
+		r.Error = awserr.New("InvalidVpcPeeringConnectionID.NotFound", "The vpcPeeringConnection ID 'pcx-12345678' does not exist", nil)
 	})
+
+	if _, err := tfec2.WaitVPCPeeringConnectionDeleted(context.Background(), conn, "pcx-12345678", 1*time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := describes, 2; got != want {
+		t.Errorf("got %d DescribeVpcPeeringConnections calls, expected %d", got, want)
+	}
 }
 
-func TestAccVPCPeeringConnection_options(t *testing.T) {
-	var v ec2.VpcPeeringConnection
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_vpc_peering_connection.test"
+func TestWaitVPCPeeringConnectionActiveFailed(t *testing.T) {
+	testCases := map[string]struct {
+		statusCode      string
+		statusMessage   string
+		expectedMessage string
+	}{
+		"failed": {
+			statusCode:      ec2.VpcPeeringConnectionStateReasonCodeFailed,
+			statusMessage:   "Overlapping CIDR range",
+			expectedMessage: "Overlapping CIDR range",
+		},
+		"deleting": {
+			statusCode:      ec2.VpcPeeringConnectionStateReasonCodeDeleting,
+			expectedMessage: "connection is being deleted",
+		},
+		"deleted": {
+			statusCode:      ec2.VpcPeeringConnectionStateReasonCodeDeleted,
+			statusMessage:   "Deleted by 123456789012",
+			expectedMessage: "connection is being deleted (Deleted by 123456789012)",
+		},
+	}
 
-	testAccepterChange := func(*terraform.State) error {
-		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
-		log.Printf("[DEBUG] Test change to the VPC Peering Connection Options.")
+	for name, testCase := range testCases {
+		testCase := testCase
 
-		_, err := conn.ModifyVpcPeeringConnectionOptions(
-			&ec2.ModifyVpcPeeringConnectionOptionsInput{
-				VpcPeeringConnectionId: v.VpcPeeringConnectionId,
-				AccepterPeeringConnectionOptions: &ec2.PeeringConnectionOptionsRequest{
-					AllowDnsResolutionFromRemoteVpc: aws.Bool(false),
-				},
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+				r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{{
+					Status: &ec2.VpcPeeringConnectionStateReason{
+						Code:    aws.String(testCase.statusCode),
+						Message: aws.String(testCase.statusMessage),
+					},
+					VpcPeeringConnectionId: aws.String("pcx-12345678"),
+				}}
 			})
 
-		return err
+			_, err := tfec2.WaitVPCPeeringConnectionActive(context.Background(), conn, "pcx-12345678", 1*time.Minute)
+
+			var failedErr *tfec2.VPCPeeringConnectionFailedError
+
+			if !errors.As(err, &failedErr) {
+				t.Fatalf("expected VPCPeeringConnectionFailedError, got: %v", err)
+			}
+
+			if got, want := failedErr.ID, "pcx-12345678"; got != want {
+				t.Errorf("got ID %q, expected %q", got, want)
+			}
+
+			if got, want := failedErr.StatusCode, testCase.statusCode; got != want {
+				t.Errorf("got status code %q, expected %q", got, want)
+			}
+
+			if got, want := failedErr.Message, testCase.expectedMessage; got != want {
+				t.Errorf("got message %q, expected %q", got, want)
+			}
+		})
 	}
+}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccVPCPeeringConnectionConfig_accepterRequesterOptions(rName),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckVPCPeeringConnectionExists(
-						resourceName,
-						&v,
-					),
-					// Requester's view:
-					resource.TestCheckResourceAttr(
-						resourceName,
-						"requester.#",
-						"1",
-					),
-					resource.TestCheckResourceAttr(
-						resourceName,
-						"requester.0.allow_remote_vpc_dns_resolution",
-						"false",
-					),
-					resource.TestCheckResourceAttr(
-						resourceName,
-						"requester.0.allow_classic_link_to_remote_vpc",
-						"true",
-					),
-					resource.TestCheckResourceAttr(
-						resourceName,
-						"requester.0.allow_vpc_to_remote_classic_link",
-						"true",
-					),
-					// Accepter's view:
-					resource.TestCheckResourceAttr(
-						resourceName,
-						"accepter.#",
-						"1",
-					),
-					resource.TestCheckResourceAttr(
-						resourceName,
-						"accepter.0.allow_remote_vpc_dns_resolution",
-						"true",
-					),
-					resource.TestCheckResourceAttr(
-						resourceName,
-						"accepter.0.allow_classic_link_to_remote_vpc",
-						"false",
-					),
-					resource.TestCheckResourceAttr(
-						resourceName,
-						"accepter.0.allow_vpc_to_remote_classic_link",
-						"false",
-					),
-					testAccepterChange,
-				),
-				ExpectNonEmptyPlan: true,
-			},
-			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{
-					"auto_accept",
+func TestWaitVPCPeeringConnectionActiveFailedPeerAccount(t *testing.T) {
+	testCases := map[string]struct {
+		statusMessage       string
+		expectedPeerAccount bool
+		expectedErrorText   string
+	}{
+		"generic": {
+			// AWS's generic failure message doesn't identify the peer account as the cause.
+			statusMessage:     "Failed due to incorrect VPC-ID, Account ID, or overlapping CIDR range",
+			expectedErrorText: "failed: Failed due to incorrect VPC-ID, Account ID, or overlapping CIDR range",
+		},
+		"suspended account": {
+			statusMessage:       "The peer account is suspended",
+			expectedPeerAccount: true,
+			expectedErrorText:   "the peer account (222222222222) is active, not suspended or closed",
+		},
+		"closed account": {
+			statusMessage:       "Closed AWS account",
+			expectedPeerAccount: true,
+			expectedErrorText:   "the peer account (222222222222) is active",
+		},
+		"other": {
+			statusMessage:     "Overlapping CIDR range",
+			expectedErrorText: "failed: Overlapping CIDR range",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+				switch r.Operation.Name {
+				case "DescribeVpcPeeringConnections":
+					r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{{
+						AccepterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+							OwnerId: aws.String("222222222222"),
+							VpcId:   aws.String("vpc-11111111"),
+						},
+						RequesterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+							CidrBlock: aws.String("10.0.0.0/16"),
+							OwnerId:   aws.String("111111111111"),
+							VpcId:     aws.String("vpc-22222222"),
+						},
+						Status: &ec2.VpcPeeringConnectionStateReason{
+							Code:    aws.String(ec2.VpcPeeringConnectionStateReasonCodeFailed),
+							Message: aws.String(testCase.statusMessage),
+						},
+						VpcPeeringConnectionId: aws.String("pcx-12345678"),
+					}}
+				case "DescribeVpcs":
+					// The peer VPC isn't visible to the requester's account.
+					r.Error = awserr.New("InvalidVpcID.NotFound", "The vpc ID 'vpc-11111111' does not exist", nil)
+				default:
+					t.Errorf("unexpected operation: %s", r.Operation.Name)
+				}
+			})
+
+			_, err := tfec2.WaitVPCPeeringConnectionActive(context.Background(), conn, "pcx-12345678", 1*time.Minute)
+
+			var failedErr *tfec2.VPCPeeringConnectionFailedError
+
+			if !errors.As(err, &failedErr) {
+				t.Fatalf("expected VPCPeeringConnectionFailedError, got: %v", err)
+			}
+
+			if got, want := failedErr.PeerAccountInvalid, testCase.expectedPeerAccount; got != want {
+				t.Errorf("got PeerAccountInvalid %t, expected %t", got, want)
+			}
+
+			if got, want := failedErr.Error(), testCase.expectedErrorText; !strings.Contains(got, want) {
+				t.Errorf("expected error containing %q, got: %s", want, got)
+			}
+		})
+	}
+}
+
+func TestStatusVPCPeeringConnectionActiveFailedOverlappingCIDRBlocks(t *testing.T) {
+	var describeVPCs int
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		switch r.Operation.Name {
+		case "DescribeVpcPeeringConnections":
+			r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{{
+				AccepterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+					VpcId: aws.String("vpc-11111111"),
+				},
+				RequesterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+					CidrBlock: aws.String("10.0.0.0/16"),
+					CidrBlockSet: []*ec2.CidrBlock{
+						{CidrBlock: aws.String("10.0.0.0/16")},
+						{CidrBlock: aws.String("10.2.0.0/16")},
+					},
+					VpcId: aws.String("vpc-22222222"),
+				},
+				Status: &ec2.VpcPeeringConnectionStateReason{
+					Code:    aws.String(ec2.VpcPeeringConnectionStateReasonCodeFailed),
+					Message: aws.String("Overlapping CIDR range"),
 				},
+				VpcPeeringConnectionId: aws.String("pcx-12345678"),
+			}}
+		case "DescribeVpcs":
+			describeVPCs++
+
+			if got, want := aws.StringValueSlice(r.Params.(*ec2.DescribeVpcsInput).VpcIds), []string{"vpc-11111111"}; !reflect.DeepEqual(got, want) {
+				t.Errorf("got VPC IDs %v, expected %v", got, want)
+			}
+
+			r.Data.(*ec2.DescribeVpcsOutput).Vpcs = []*ec2.Vpc{{
+				CidrBlockAssociationSet: []*ec2.VpcCidrBlockAssociation{
+					{
+						CidrBlock:      aws.String("10.0.128.0/17"),
+						CidrBlockState: &ec2.VpcCidrBlockState{State: aws.String(ec2.VpcCidrBlockStateCodeAssociated)},
+					},
+					{
+						CidrBlock:      aws.String("10.1.0.0/16"),
+						CidrBlockState: &ec2.VpcCidrBlockState{State: aws.String(ec2.VpcCidrBlockStateCodeAssociated)},
+					},
+					{
+						CidrBlock:      aws.String("10.2.0.0/24"),
+						CidrBlockState: &ec2.VpcCidrBlockState{State: aws.String(ec2.VpcCidrBlockStateCodeDisassociated)},
+					},
+				},
+				VpcId: aws.String("vpc-11111111"),
+			}}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+
+	_, _, err := tfec2.StatusVPCPeeringConnectionActive(context.Background(), conn, "pcx-12345678")()
+
+	var failedErr *tfec2.VPCPeeringConnectionFailedError
+
+	if !errors.As(err, &failedErr) {
+		t.Fatalf("expected VPCPeeringConnectionFailedError, got: %v", err)
+	}
+
+	if got, want := describeVPCs, 1; got != want {
+		t.Errorf("got %d DescribeVpcs calls, expected %d", got, want)
+	}
+
+	if got, want := failedErr.OverlappingCIDRBlocks, []string{"10.0.0.0/16 (vpc-22222222) and 10.0.128.0/17 (vpc-11111111)"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got overlapping CIDR blocks %v, expected %v", got, want)
+	}
+
+	if got, want := failedErr.Error(), "overlapping CIDR blocks: 10.0.0.0/16 (vpc-22222222) and 10.0.128.0/17 (vpc-11111111)"; !strings.Contains(got, want) {
+		t.Errorf("expected error containing %q, got: %s", want, got)
+	}
+}
+
+func TestResourceVPCPeeringConnectionCreatePeerOwnerID(t *testing.T) {
+	testCases := map[string]struct {
+		config              map[string]interface{}
+		expectedPeerOwnerID *string
+	}{
+		"same region": {
+			config: map[string]interface{}{},
+		},
+		"same region peer owner": {
+			config: map[string]interface{}{
+				"peer_owner_id": "222222222222",
 			},
-			{
-				Config: testAccVPCPeeringConnectionConfig_accepterRequesterOptions(rName),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckVPCPeeringConnectionExists(
-						resourceName,
-						&v,
-					),
-					// Requester's view:
-					resource.TestCheckResourceAttr(
-						resourceName,
-						"requester.#",
-						"1",
-					),
-					resource.TestCheckResourceAttr(
-						resourceName,
-						"requester.0.allow_remote_vpc_dns_resolution",
-						"false",
-					),
-					resource.TestCheckResourceAttr(
-						resourceName,
-						"requester.0.allow_classic_link_to_remote_vpc",
-						"true",
-					),
-					resource.TestCheckResourceAttr(
-						resourceName,
-						"requester.0.allow_vpc_to_remote_classic_link",
-						"true",
-					),
-					// Accepter's view:
-					resource.TestCheckResourceAttr(
-						resourceName,
-						"accepter.#",
-						"1",
-					),
-					resource.TestCheckResourceAttr(
-						resourceName,
-						"accepter.0.allow_remote_vpc_dns_resolution",
-						"true",
-					),
-					resource.TestCheckResourceAttr(
-						resourceName,
-						"accepter.0.allow_classic_link_to_remote_vpc",
-						"false",
-					),
-					resource.TestCheckResourceAttr(
-						resourceName,
-						"accepter.0.allow_vpc_to_remote_classic_link",
-						"false",
-					),
-				),
+			expectedPeerOwnerID: aws.String("222222222222"),
+		},
+		"cross-region": {
+			config: map[string]interface{}{
+				"peer_region": endpoints.UsEast1RegionID,
+			},
+			expectedPeerOwnerID: aws.String("111111111111"),
+		},
+		"cross-region peer owner": {
+			config: map[string]interface{}{
+				"peer_owner_id": "222222222222",
+				"peer_region":   endpoints.UsEast1RegionID,
 			},
+			expectedPeerOwnerID: aws.String("222222222222"),
 		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var input *ec2.CreateVpcPeeringConnectionInput
+			conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+				if got, want := r.Operation.Name, "CreateVpcPeeringConnection"; got != want {
+					t.Errorf("unexpected operation: %s", got)
+				}
+
+				input = r.Params.(*ec2.CreateVpcPeeringConnectionInput)
+				// Stop once the request has been captured.
+				r.Error = awserr.New("UnauthorizedOperation", "not authorized", nil)
+			})
+
+			config := map[string]interface{}{
+				"peer_vpc_id": "vpc-11111111",
+				"vpc_id":      "vpc-22222222",
+			}
+			for k, v := range testCase.config {
+				config[k] = v
+			}
+
+			r := tfec2.ResourceVPCPeeringConnection()
+			d := schema.TestResourceDataRaw(t, r.Schema, config)
+			meta := &conns.AWSClient{
+				AccountID: "111111111111",
+				EC2Conn:   conn,
+				Region:    endpoints.UsWest2RegionID,
+			}
+
+			r.CreateWithoutTimeout(context.Background(), d, meta)
+
+			if input == nil {
+				t.Fatal("CreateVpcPeeringConnection not called")
+			}
+
+			if got, want := aws.StringValue(input.PeerOwnerId), aws.StringValue(testCase.expectedPeerOwnerID); got != want || (input.PeerOwnerId == nil) != (testCase.expectedPeerOwnerID == nil) {
+				t.Errorf("got PeerOwnerId %v, expected %v", input.PeerOwnerId, testCase.expectedPeerOwnerID)
+			}
+		})
+	}
+}
+
+func TestResourceVPCPeeringConnectionCreateCrossAccountPendingAcceptance(t *testing.T) {
+	t.Parallel()
+
+	var describes int
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		switch r.Operation.Name {
+		case "CreateVpcPeeringConnection":
+			r.Data.(*ec2.CreateVpcPeeringConnectionOutput).VpcPeeringConnection = &ec2.VpcPeeringConnection{
+				VpcPeeringConnectionId: aws.String("pcx-12345678"),
+			}
+		case "DescribeVpcPeeringConnections":
+			describes++
+
+			// Without auto_accept a cross-account VPC Peering Connection remains pending acceptance by the peer.
+			r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{testVPCPeeringConnection("222222222222", ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, nil, nil)}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
 	})
+	conn.Config.Region = aws.String(endpoints.UsWest2RegionID)
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		EC2Conn:   conn,
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnection()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"peer_owner_id": "222222222222",
+		"peer_vpc_id":   "vpc-11111111",
+		"vpc_id":        "vpc-22222222",
+	})
+
+	if diags := r.CreateWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := d.Id(), "pcx-12345678"; got != want {
+		t.Errorf("got ID %q, expected %q", got, want)
+	}
+
+	if got, want := d.Get("accept_status").(string), ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance; got != want {
+		t.Errorf("got accept_status %q, expected %q", got, want)
+	}
+
+	if describes == 0 {
+		t.Error("expected DescribeVpcPeeringConnections to be called")
+	}
 }
 
-func TestAccVPCPeeringConnection_failedState(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func TestResourceVPCPeeringConnectionCreateCrossAccountPendingAcceptanceOptions(t *testing.T) {
+	t.Parallel()
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config:      testAccVPCPeeringConnectionConfig_failedState(rName),
-				ExpectError: regexp.MustCompile(`unexpected state 'failed'`),
-			},
-		},
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		switch r.Operation.Name {
+		case "CreateVpcPeeringConnection":
+			r.Data.(*ec2.CreateVpcPeeringConnectionOutput).VpcPeeringConnection = &ec2.VpcPeeringConnection{
+				VpcPeeringConnectionId: aws.String("pcx-12345678"),
+			}
+		case "DescribeVpcPeeringConnections":
+			r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{testVPCPeeringConnection("222222222222", ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, nil, nil)}
+		default:
+			// In particular, no attempt is made to modify the options.
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+	conn.Config.Region = aws.String(endpoints.UsWest2RegionID)
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		EC2Conn:   conn,
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnection()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"peer_owner_id": "222222222222",
+		"peer_vpc_id":   "vpc-11111111",
+		"requester": []interface{}{map[string]interface{}{
+			"allow_remote_vpc_dns_resolution": true,
+		}},
+		"vpc_id": "vpc-22222222",
+	})
+
+	if diags := r.CreateWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// The options haven't been applied, so the next plan shows them as a change to be applied once the peer accepts.
+	if got := d.Get("requester").([]interface{}); len(got) != 0 {
+		t.Errorf("got requester %v, expected none", got)
+	}
+
+	if got, want := d.Get("peering_options_managed_by").(string), ""; got != want {
+		t.Errorf("got peering_options_managed_by %q, expected %q", got, want)
+	}
+}
+
+func TestResourceVPCPeeringConnectionCreateWaitForAccepter(t *testing.T) {
+	t.Parallel()
+
+	var describes int
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		switch r.Operation.Name {
+		case "CreateVpcPeeringConnection":
+			r.Data.(*ec2.CreateVpcPeeringConnectionOutput).VpcPeeringConnection = &ec2.VpcPeeringConnection{
+				VpcPeeringConnectionId: aws.String("pcx-12345678"),
+			}
+		case "DescribeVpcPeeringConnections":
+			describes++
+
+			// The peer accepts the VPC Peering Connection after it has been pending acceptance for a while.
+			statusCode := ec2.VpcPeeringConnectionStateReasonCodeActive
+			if describes <= 2 {
+				statusCode = ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance
+			}
+
+			r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{testVPCPeeringConnection("222222222222", statusCode, nil, nil)}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+	conn.Config.Region = aws.String(endpoints.UsWest2RegionID)
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		EC2Conn:   conn,
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnection()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"peer_owner_id":     "222222222222",
+		"peer_vpc_id":       "vpc-11111111",
+		"vpc_id":            "vpc-22222222",
+		"wait_for_accepter": true,
 	})
+
+	if diags := r.CreateWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := d.Get("accept_status").(string), ec2.VpcPeeringConnectionStateReasonCodeActive; got != want {
+		t.Errorf("got accept_status %q, expected %q", got, want)
+	}
 }
 
-func TestAccVPCPeeringConnection_peerRegionAutoAccept(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func TestResourceVPCPeeringConnectionCreateWaitFailed(t *testing.T) {
+	t.Parallel()
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck: func() {
-			acctest.PreCheck(t)
-			acctest.PreCheckMultipleRegion(t, 2)
-		},
-		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
-		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
-		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config:      testAccVPCPeeringConnectionConfig_alternateRegionAutoAccept(rName, true),
-				ExpectError: regexp.MustCompile("`peer_region` cannot be set whilst `auto_accept` is `true` when creating an EC2 VPC Peering Connection"),
-			},
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		switch r.Operation.Name {
+		case "CreateVpcPeeringConnection":
+			r.Data.(*ec2.CreateVpcPeeringConnectionOutput).VpcPeeringConnection = &ec2.VpcPeeringConnection{
+				VpcPeeringConnectionId: aws.String("pcx-12345678"),
+			}
+		case "DescribeVpcPeeringConnections":
+			r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{{
+				Status: &ec2.VpcPeeringConnectionStateReason{
+					Code:    aws.String(ec2.VpcPeeringConnectionStateReasonCodeFailed),
+					Message: aws.String("Failed due to incorrect VPC-ID, Account ID, or overlapping CIDR range"),
+				},
+				VpcPeeringConnectionId: aws.String("pcx-12345678"),
+			}}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		EC2Conn:   conn,
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnection()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"peer_vpc_id": "vpc-11111111",
+		"tags": map[string]interface{}{
+			"Name": "test",
 		},
+		"vpc_id": "vpc-22222222",
 	})
+
+	diags := r.CreateWithoutTimeout(context.Background(), d, meta)
+
+	if !diags.HasError() {
+		t.Fatal("expected error, got none")
+	}
+
+	// The VPC Peering Connection exists, so it's tracked in state and named in the error.
+	if got, want := d.Id(), "pcx-12345678"; got != want {
+		t.Errorf("got ID %q, expected %q", got, want)
+	}
+
+	if got, want := diags[0].Summary, "pcx-12345678"; !strings.Contains(got, want) {
+		t.Errorf("expected error containing %q, got: %s", want, got)
+	}
 }
 
-func TestAccVPCPeeringConnection_region(t *testing.T) {
-	var v ec2.VpcPeeringConnection
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_vpc_peering_connection.test"
+func TestResourceVPCPeeringConnectionCreateOutcomeUnknown(t *testing.T) {
+	t.Parallel()
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck: func() {
-			acctest.PreCheck(t)
-			acctest.PreCheckMultipleRegion(t, 2)
+	testCases := map[string]struct {
+		created         bool
+		expectedCreates int
+		expectedID      string
+	}{
+		"created": {
+			created:         true,
+			expectedCreates: 1,
+			expectedID:      "pcx-12345678",
 		},
-		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
-		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
-		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccVPCPeeringConnectionConfig_alternateRegionAutoAccept(rName, false),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckVPCPeeringConnectionExists(
-						resourceName,
-						&v,
-					),
-					resource.TestCheckResourceAttr(
-						resourceName,
-						"accept_status",
-						"pending-acceptance",
-					),
-				),
-			},
+		"not created": {
+			expectedCreates: 2,
+			expectedID:      "pcx-87654321",
 		},
-	})
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var creates int
+			conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+				switch r.Operation.Name {
+				case "CreateVpcPeeringConnection":
+					// The first request's response is lost.
+					if creates++; creates == 1 {
+						r.Error = awserr.NewRequestFailure(awserr.New("InternalError", "An internal error has occurred", nil), http.StatusInternalServerError, "1")
+
+						return
+					}
+
+					r.Data.(*ec2.CreateVpcPeeringConnectionOutput).VpcPeeringConnection = &ec2.VpcPeeringConnection{
+						VpcPeeringConnectionId: aws.String("pcx-87654321"),
+					}
+				case "DescribeVpcPeeringConnections":
+					input := r.Params.(*ec2.DescribeVpcPeeringConnectionsInput)
+
+					if len(input.VpcPeeringConnectionIds) > 0 {
+						// Stop the create once the VPC Peering Connection is tracked.
+						r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{{
+							Status:                 &ec2.VpcPeeringConnectionStateReason{Code: aws.String(ec2.VpcPeeringConnectionStateReasonCodeFailed)},
+							VpcPeeringConnectionId: input.VpcPeeringConnectionIds[0],
+						}}
+
+						return
+					}
+
+					filters := map[string][]string{}
+
+					for _, v := range input.Filters {
+						filters[aws.StringValue(v.Name)] = aws.StringValueSlice(v.Values)
+					}
+
+					if got, want := filters, map[string][]string{
+						"accepter-vpc-info.vpc-id":  {"vpc-11111111"},
+						"requester-vpc-info.vpc-id": {"vpc-22222222"},
+						"status-code": {
+							ec2.VpcPeeringConnectionStateReasonCodeInitiatingRequest,
+							ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance,
+							ec2.VpcPeeringConnectionStateReasonCodeProvisioning,
+						},
+						"tag:Name": {"test"},
+					}; !reflect.DeepEqual(got, want) {
+						t.Errorf("got filters %v, expected %v", got, want)
+					}
+
+					if testCase.created {
+						r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{
+							testVPCPeeringConnection("111111111111", ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, nil, nil),
+						}
+					}
+				default:
+					t.Errorf("unexpected operation: %s", r.Operation.Name)
+				}
+			})
+			meta := &conns.AWSClient{
+				AccountID: "111111111111",
+				EC2Conn:   conn,
+				Partition: endpoints.AwsPartitionID,
+				Region:    endpoints.UsWest2RegionID,
+			}
+
+			r := tfec2.ResourceVPCPeeringConnection()
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+				"peer_vpc_id": "vpc-11111111",
+				"tags": map[string]interface{}{
+					"Name": "test",
+				},
+				"vpc_id": "vpc-22222222",
+			})
+
+			if diags := r.CreateWithoutTimeout(context.Background(), d, meta); !diags.HasError() {
+				t.Fatal("expected error, got none")
+			}
+
+			if got, want := creates, testCase.expectedCreates; got != want {
+				t.Errorf("got %d CreateVpcPeeringConnection calls, expected %d", got, want)
+			}
+
+			if got, want := d.Id(), testCase.expectedID; got != want {
+				t.Errorf("got ID %q, expected %q", got, want)
+			}
+		})
+	}
 }
 
-// Tests the peering connection acceptance functionality for same region, same account.
-func TestAccVPCPeeringConnection_accept(t *testing.T) {
-	var v ec2.VpcPeeringConnection
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_vpc_peering_connection.test"
+func TestResourceVPCPeeringConnectionCreateSameAccountWithoutAutoAccept(t *testing.T) {
+	t.Parallel()
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config: testAccVPCPeeringConnectionConfig_autoAccept(rName, false),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckVPCPeeringConnectionExists(
-						resourceName,
-						&v,
-					),
-					resource.TestCheckResourceAttr(
-						resourceName,
-						"accept_status",
-						"pending-acceptance",
-					),
-				),
+	testCases := []struct {
+		name                 string
+		config               map[string]interface{}
+		expectedAcceptStatus string
+		expectedOperations   []string
+	}{
+		{
+			name: "options",
+			config: map[string]interface{}{
+				"accepter": []interface{}{map[string]interface{}{
+					"allow_remote_vpc_dns_resolution": true,
+				}},
+				"auto_accept": false,
+				"peer_vpc_id": "vpc-11111111",
+				"vpc_id":      "vpc-22222222",
 			},
-			{
-				Config: testAccVPCPeeringConnectionConfig_autoAccept(rName, true),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckVPCPeeringConnectionExists(
-						resourceName,
-						&v,
-					),
-					resource.TestCheckResourceAttr(
-						resourceName,
-						"accept_status",
-						"active",
-					),
-				),
+			expectedAcceptStatus: ec2.VpcPeeringConnectionStateReasonCodeActive,
+			expectedOperations:   []string{"CreateVpcPeeringConnection", "AcceptVpcPeeringConnection", "ModifyVpcPeeringConnectionOptions"},
+		},
+		{
+			// Without options, the VPC Peering Connection is left for an aws_vpc_peering_connection_accepter to accept.
+			name: "no options",
+			config: map[string]interface{}{
+				"auto_accept": false,
+				"peer_vpc_id": "vpc-11111111",
+				"vpc_id":      "vpc-22222222",
 			},
-			// Tests that changing 'auto_accept' back to false keeps the connection active.
-			{
-				Config: testAccVPCPeeringConnectionConfig_autoAccept(rName, false),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckVPCPeeringConnectionExists(
-						resourceName,
-						&v,
-					),
-					resource.TestCheckResourceAttr(
-						resourceName,
-						"accept_status",
-						"active",
-					),
-				),
+			expectedAcceptStatus: ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance,
+			expectedOperations:   []string{"CreateVpcPeeringConnection"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			var operations []string
+			var accepted, modified bool
+			conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+				if r.Operation.Name != "DescribeVpcPeeringConnections" {
+					operations = append(operations, r.Operation.Name)
+				}
+
+				switch r.Operation.Name {
+				case "AcceptVpcPeeringConnection":
+					accepted = true
+				case "CreateVpcPeeringConnection":
+					r.Data.(*ec2.CreateVpcPeeringConnectionOutput).VpcPeeringConnection = &ec2.VpcPeeringConnection{
+						VpcPeeringConnectionId: aws.String("pcx-12345678"),
+					}
+				case "DescribeVpcPeeringConnections":
+					statusCode := ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance
+					if accepted {
+						statusCode = ec2.VpcPeeringConnectionStateReasonCodeActive
+					}
+
+					r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{testVPCPeeringConnection("111111111111", statusCode, testVPCPeeringConnectionOptions(modified), testVPCPeeringConnectionOptions(false))}
+				case "ModifyVpcPeeringConnectionOptions":
+					modified = true
+				default:
+					t.Errorf("unexpected operation: %s", r.Operation.Name)
+				}
+			})
+			conn.Config.Region = aws.String(endpoints.UsWest2RegionID)
+			meta := &conns.AWSClient{
+				AccountID: "111111111111",
+				EC2Conn:   conn,
+				Partition: endpoints.AwsPartitionID,
+				Region:    endpoints.UsWest2RegionID,
+			}
+
+			r := tfec2.ResourceVPCPeeringConnection()
+			d := schema.TestResourceDataRaw(t, r.Schema, testCase.config)
+
+			if diags := r.CreateWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := operations, testCase.expectedOperations; !reflect.DeepEqual(got, want) {
+				t.Errorf("got operations %v, expected %v", got, want)
+			}
+
+			if got, want := d.Get("accept_status").(string), testCase.expectedAcceptStatus; got != want {
+				t.Errorf("got accept_status %q, expected %q", got, want)
+			}
+		})
+	}
+}
+
+func TestResourceVPCPeeringConnectionAccepterCreateOptionsAfterAccept(t *testing.T) {
+	t.Parallel()
+
+	var operations []string
+	var accepted, modified bool
+	var modifies int
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		operations = append(operations, r.Operation.Name)
+
+		switch r.Operation.Name {
+		case "AcceptVpcPeeringConnection":
+			accepted = true
+		case "DescribeVpcPeeringConnections":
+			statusCode := ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance
+			if accepted {
+				statusCode = ec2.VpcPeeringConnectionStateReasonCodeActive
+			}
+
+			r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{testVPCPeeringConnection("111111111111", statusCode, testVPCPeeringConnectionOptions(modified), testVPCPeeringConnectionOptions(false))}
+		case "ModifyVpcPeeringConnectionOptions":
+			// The accepted VPC Peering Connection isn't immediately visible as active to the options API.
+			if modifies++; modifies == 1 {
+				r.Error = awserr.New("OperationNotPermitted", "Peering pcx-12345678 is not active. Peering options can be added only to active peerings.", nil)
+
+				return
+			}
+
+			modified = true
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+	conn.Config.Region = aws.String(endpoints.UsWest2RegionID)
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		EC2Conn:   conn,
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnectionAccepter()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"accepter": []interface{}{map[string]interface{}{
+			"allow_remote_vpc_dns_resolution": true,
+		}},
+		"auto_accept":               true,
+		"vpc_peering_connection_id": "pcx-12345678",
+	})
+
+	if diags := r.CreateWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// The options are applied in the same create as the acceptance, once the VPC Peering Connection is active.
+	var sequence []string
+	for _, operation := range operations {
+		if operation != "DescribeVpcPeeringConnections" {
+			sequence = append(sequence, operation)
+		}
+	}
+
+	if got, want := sequence, []string{"AcceptVpcPeeringConnection", "ModifyVpcPeeringConnectionOptions", "ModifyVpcPeeringConnectionOptions"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got operations %v, expected %v", got, want)
+	}
+
+	if got, want := d.Get("accepter.0.allow_remote_vpc_dns_resolution").(bool), true; got != want {
+		t.Errorf("got accepter.0.allow_remote_vpc_dns_resolution %t, expected %t", got, want)
+	}
+
+	if got, want := d.Get("peering_options_managed_by").(string), "inline"; got != want {
+		t.Errorf("got peering_options_managed_by %q, expected %q", got, want)
+	}
+}
+
+func TestResourceVPCPeeringConnectionAccepterUpdate(t *testing.T) {
+	var tagged bool
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		switch r.Operation.Name {
+		case "CreateTags":
+			tagged = true
+		case "DescribeVpcPeeringConnections":
+			r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{testVPCPeeringConnection("111111111111", ec2.VpcPeeringConnectionStateReasonCodeActive, nil, nil)}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+	conn.Config.Region = aws.String(endpoints.UsWest2RegionID)
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		EC2Conn:   conn,
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnectionAccepter()
+	old := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"auto_accept":               true,
+		"vpc_peering_connection_id": "pcx-12345678",
+	})
+	old.SetId("pcx-12345678")
+	old.Set("accept_status", ec2.VpcPeeringConnectionStateReasonCodeActive)
+	state := old.State()
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"auto_accept":               true,
+		"tags":                      map[string]interface{}{"Side": "Accepter"},
+		"vpc_peering_connection_id": "pcx-12345678",
+	}), meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error diffing: %s", err)
+	}
+
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The accepter shares the Update function, but not all of the arguments, of aws_vpc_peering_connection.
+	if diags := r.UpdateWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !tagged {
+		t.Error("expected the accepter's tags to be updated")
+	}
+}
+
+func TestResourceVPCPeeringConnectionAccepterDeleteRejectOnDestroy(t *testing.T) {
+	testCases := map[string]struct {
+		statusCode      string
+		rejectOnDestroy bool
+		expectedRejects int
+	}{
+		"pending acceptance": {
+			statusCode:      ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance,
+			rejectOnDestroy: true,
+			expectedRejects: 1,
+		},
+		// An accepted VPC Peering Connection can't be rejected, so it's only removed from state.
+		"active": {
+			statusCode:      ec2.VpcPeeringConnectionStateReasonCodeActive,
+			rejectOnDestroy: true,
+		},
+		"not set": {
+			statusCode: ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			var rejects int
+			conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+				switch r.Operation.Name {
+				case "DescribeVpcPeeringConnections":
+					r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{{
+						Status:                 &ec2.VpcPeeringConnectionStateReason{Code: aws.String(testCase.statusCode)},
+						VpcPeeringConnectionId: aws.String("pcx-12345678"),
+					}}
+				case "RejectVpcPeeringConnection":
+					rejects++
+				default:
+					t.Errorf("unexpected operation: %s", r.Operation.Name)
+				}
+			})
+			meta := &conns.AWSClient{
+				EC2Conn: conn,
+			}
+
+			r := tfec2.ResourceVPCPeeringConnectionAccepter()
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+				"reject_on_destroy":         testCase.rejectOnDestroy,
+				"vpc_peering_connection_id": "pcx-12345678",
+			})
+			d.SetId("pcx-12345678")
+
+			if diags := r.DeleteWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := rejects, testCase.expectedRejects; got != want {
+				t.Errorf("got %d RejectVpcPeeringConnection calls, expected %d", got, want)
+			}
+		})
+	}
+}
+
+func TestResourceVPCPeeringConnectionDelete(t *testing.T) {
+	invalidStateTransitionErr := awserr.New("InvalidStateTransition", "Invalid state transition for pcx-12345678, attempted to transition from provisioning to deleting", nil)
+	dependencyViolationErr := awserr.New("DependencyViolation", "The vpc peering connection 'pcx-12345678' has dependencies and cannot be deleted", nil)
+
+	testCases := map[string]struct {
+		deleteErrs        []error
+		statusCode        string
+		forceDestroy      bool
+		retainOnDestroy   bool
+		skipDestroyWait   bool
+		expectedDeletes   int
+		expectedErrorText string
+	}{
+		"success": {
+			expectedDeletes: 1,
+		},
+		"skip destroy wait": {
+			skipDestroyWait: true,
+			expectedDeletes: 1,
+		},
+		"retain on destroy": {
+			retainOnDestroy: true,
+			expectedDeletes: 0,
+		},
+		"not found": {
+			deleteErrs:      []error{awserr.New("InvalidVpcPeeringConnectionID.NotFound", "not found", nil)},
+			expectedDeletes: 1,
+		},
+		"failed": {
+			deleteErrs:      []error{invalidStateTransitionErr},
+			statusCode:      ec2.VpcPeeringConnectionStateReasonCodeFailed,
+			expectedDeletes: 1,
+		},
+		"rejected": {
+			deleteErrs:      []error{invalidStateTransitionErr},
+			statusCode:      ec2.VpcPeeringConnectionStateReasonCodeRejected,
+			expectedDeletes: 1,
+		},
+		"expired": {
+			deleteErrs:      []error{invalidStateTransitionErr},
+			statusCode:      ec2.VpcPeeringConnectionStateReasonCodeExpired,
+			expectedDeletes: 1,
+		},
+		"deleted": {
+			deleteErrs:      []error{invalidStateTransitionErr},
+			statusCode:      ec2.VpcPeeringConnectionStateReasonCodeDeleted,
+			expectedDeletes: 1,
+		},
+		"provisioning": {
+			deleteErrs:      []error{invalidStateTransitionErr},
+			statusCode:      ec2.VpcPeeringConnectionStateReasonCodeProvisioning,
+			expectedDeletes: 2,
+		},
+		"rejected externally": {
+			deleteErrs:      []error{awserr.New("InvalidStateTransition", "Invalid state transition for pcx-12345678, the peering connection has been rejected", nil)},
+			statusCode:      ec2.VpcPeeringConnectionStateReasonCodeRejected,
+			expectedDeletes: 1,
+		},
+		"dependency violation": {
+			deleteErrs:        []error{dependencyViolationErr},
+			expectedDeletes:   1,
+			expectedErrorText: "DependencyViolation",
+		},
+		"dependency violation force destroy": {
+			deleteErrs:      []error{dependencyViolationErr},
+			forceDestroy:    true,
+			expectedDeletes: 2,
+		},
+		"other error": {
+			deleteErrs:        []error{awserr.New("UnauthorizedOperation", "not authorized", nil)},
+			expectedDeletes:   1,
+			expectedErrorText: "UnauthorizedOperation",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			var deletes, describes int
+			deleted := false
+
+			conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+				switch r.Operation.Name {
+				case "DeleteVpcPeeringConnection":
+					deletes++
+
+					if deletes <= len(testCase.deleteErrs) {
+						r.Error = testCase.deleteErrs[deletes-1]
+
+						return
+					}
+
+					deleted = true
+				case "DescribeVpcPeeringConnections":
+					describes++
+					statusCode := testCase.statusCode
+
+					if deleted {
+						statusCode = ec2.VpcPeeringConnectionStateReasonCodeDeleted
+					}
+
+					r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{{
+						Status:                 &ec2.VpcPeeringConnectionStateReason{Code: aws.String(statusCode)},
+						VpcPeeringConnectionId: aws.String("pcx-12345678"),
+					}}
+				}
+			})
+
+			r := tfec2.ResourceVPCPeeringConnection()
+			d := r.TestResourceData()
+			d.SetId("pcx-12345678")
+			d.Set("force_destroy", testCase.forceDestroy)
+			d.Set("retain_on_destroy", testCase.retainOnDestroy)
+			d.Set("skip_destroy_wait", testCase.skipDestroyWait)
+
+			diags := r.DeleteWithoutTimeout(context.Background(), d, &conns.AWSClient{EC2Conn: conn})
+
+			if testCase.expectedErrorText == "" && diags.HasError() {
+				t.Errorf("unexpected error: %v", diags)
+			}
+
+			if testCase.expectedErrorText != "" && (!diags.HasError() || !strings.Contains(diags[0].Summary, testCase.expectedErrorText)) {
+				t.Errorf("expected error containing %q, got: %v", testCase.expectedErrorText, diags)
+			}
+
+			if deletes != testCase.expectedDeletes {
+				t.Errorf("got %d DeleteVpcPeeringConnection calls, want %d", deletes, testCase.expectedDeletes)
+			}
+
+			if (testCase.retainOnDestroy || testCase.skipDestroyWait) && describes > 0 {
+				t.Errorf("got %d DescribeVpcPeeringConnections calls, want 0", describes)
+			}
+		})
+	}
+}
+
+func TestResourceVPCPeeringConnectionDiffOrientation(t *testing.T) {
+	// State of a cross-account VPC Peering Connection read from the accepter's side.
+	state := &terraform.InstanceState{
+		ID: "pcx-12345678",
+		Attributes: map[string]string{
+			"id":                     "pcx-12345678",
+			"accept_status":          ec2.VpcPeeringConnectionStateReasonCodeActive,
+			"manage_peering_options": "true",
+			"orientation":            "accepter",
+			"peer_owner_id":          "111111111111",
+			"peer_region":            "us-west-2",
+			"peer_vpc_id":            "vpc-11111111",
+			"vpc_id":                 "vpc-22222222",
+		},
+	}
+	meta := &conns.AWSClient{
+		AccountID: "222222222222",
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	testCases := map[string]struct {
+		config              map[string]interface{}
+		expectedOrientation string
+		expectedRequiresNew bool
+	}{
+		"swapped": {
+			config: map[string]interface{}{
+				"peer_owner_id": "222222222222",
+				"peer_vpc_id":   "vpc-22222222",
+				"vpc_id":        "vpc-11111111",
 			},
-			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-				ImportStateVerifyIgnore: []string{
-					"auto_accept",
-				},
+			expectedOrientation: "requester",
+		},
+		"unchanged": {
+			config: map[string]interface{}{
+				"peer_owner_id": "111111111111",
+				"peer_vpc_id":   "vpc-11111111",
+				"vpc_id":        "vpc-22222222",
 			},
 		},
-	})
+		"different peer VPC": {
+			config: map[string]interface{}{
+				"peer_owner_id": "111111111111",
+				"peer_vpc_id":   "vpc-33333333",
+				"vpc_id":        "vpc-22222222",
+			},
+			expectedRequiresNew: true,
+		},
+		"different peer owner": {
+			config: map[string]interface{}{
+				"peer_owner_id": "333333333333",
+				"peer_vpc_id":   "vpc-11111111",
+				"vpc_id":        "vpc-22222222",
+			},
+			expectedRequiresNew: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			diff, err := tfec2.ResourceVPCPeeringConnection().Diff(context.Background(), state, terraform.NewResourceConfigRaw(testCase.config), meta)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := diff != nil && diff.RequiresNew(), testCase.expectedRequiresNew; got != want {
+				t.Errorf("got RequiresNew %t, expected %t", got, want)
+			}
+
+			if testCase.expectedRequiresNew {
+				return
+			}
+
+			if testCase.expectedOrientation == "" {
+				if diff != nil && diff.Attributes["orientation"] != nil {
+					t.Errorf("unexpected orientation diff: %#v", diff.Attributes["orientation"])
+				}
+
+				return
+			}
+
+			if diff == nil || diff.Attributes["orientation"] == nil {
+				t.Fatalf("expected orientation diff")
+			}
+
+			if got, want := diff.Attributes["orientation"].New, testCase.expectedOrientation; got != want {
+				t.Errorf("got orientation %q, expected %q", got, want)
+			}
+		})
+	}
 }
 
-// Tests that VPC peering connection options can't be set on non-active connection.
-func TestAccVPCPeeringConnection_optionsNoAutoAccept(t *testing.T) {
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+func TestResourceVPCPeeringConnectionDiffAccepterTags(t *testing.T) {
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
 
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(t) },
-		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
-		Steps: []resource.TestStep{
-			{
-				Config:      testAccVPCPeeringConnectionConfig_accepterRequesterOptionsNoAutoAccept(rName),
-				ExpectError: regexp.MustCompile(`is not active`),
+	testCases := map[string]struct {
+		config            map[string]interface{}
+		expectedErrorText string
+	}{
+		"same account and region": {
+			config:            map[string]interface{}{},
+			expectedErrorText: "can only be set for a cross-account or cross-region",
+		},
+		"cross-account": {
+			config: map[string]interface{}{
+				"peer_owner_id": "222222222222",
 			},
+			expectedErrorText: "requires `accepter_assume_role`",
 		},
-	})
+		"cross-account with accepter_assume_role": {
+			config: map[string]interface{}{
+				"accepter_assume_role": []interface{}{map[string]interface{}{
+					"role_arn": "arn:aws:iam::222222222222:role/accepter", //lintignore:AWSAT005
+				}},
+				"peer_owner_id": "222222222222",
+			},
+		},
+		"cross-region": {
+			config: map[string]interface{}{
+				"peer_region": endpoints.UsEast1RegionID,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			config := map[string]interface{}{
+				"accepter_tags": map[string]interface{}{"Side": "Accepter"},
+				"peer_vpc_id":   "vpc-11111111",
+				"vpc_id":        "vpc-22222222",
+			}
+			for k, v := range testCase.config {
+				config[k] = v
+			}
+
+			_, err := tfec2.ResourceVPCPeeringConnection().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), meta)
+
+			if testCase.expectedErrorText == "" && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			if testCase.expectedErrorText != "" && (err == nil || !strings.Contains(err.Error(), testCase.expectedErrorText)) {
+				t.Errorf("expected error containing %q, got: %v", testCase.expectedErrorText, err)
+			}
+		})
+	}
+}
+
+func TestResourceVPCPeeringConnectionDiffCrossAccountOptions(t *testing.T) {
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	testCases := map[string]struct {
+		config            map[string]interface{}
+		expectedErrorText string
+	}{
+		"same account": {
+			config: map[string]interface{}{
+				"auto_accept": true,
+			},
+		},
+		"cross-account": {
+			config: map[string]interface{}{
+				"peer_owner_id": "222222222222",
+			},
+			expectedErrorText: "`aws_vpc_peering_connection_accepter` resource",
+		},
+		"cross-account with auto_accept": {
+			config: map[string]interface{}{
+				"auto_accept":   true,
+				"peer_owner_id": "222222222222",
+			},
+			expectedErrorText: "`aws_vpc_peering_connection_accepter` resource",
+		},
+		"cross-account with auto_accept and accepter_assume_role": {
+			config: map[string]interface{}{
+				"accepter_assume_role": []interface{}{map[string]interface{}{
+					"role_arn": "arn:aws:iam::222222222222:role/accepter", //lintignore:AWSAT005
+				}},
+				"auto_accept":   true,
+				"peer_owner_id": "222222222222",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			config := map[string]interface{}{
+				"peer_vpc_id": "vpc-11111111",
+				"requester": []interface{}{map[string]interface{}{
+					"allow_remote_vpc_dns_resolution": true,
+				}},
+				"vpc_id": "vpc-22222222",
+			}
+			for k, v := range testCase.config {
+				config[k] = v
+			}
+
+			_, err := tfec2.ResourceVPCPeeringConnection().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), meta)
+
+			if testCase.expectedErrorText == "" && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			if testCase.expectedErrorText != "" && (err == nil || !strings.Contains(err.Error(), testCase.expectedErrorText)) {
+				t.Errorf("expected error containing %q, got: %v", testCase.expectedErrorText, err)
+			}
+		})
+	}
+}
+
+func TestResourceVPCPeeringConnectionDiffReservedTagKeys(t *testing.T) {
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	testCases := map[string]struct {
+		config            map[string]interface{}
+		expectedErrorText string
+	}{
+		"tags": {
+			config: map[string]interface{}{
+				"tags": map[string]interface{}{"aws:Foo": "bar", "Name": "test"},
+			},
+			expectedErrorText: "`tags` must not contain keys with the reserved \"aws:\" prefix: aws:Foo",
+		},
+		"accepter_tags": {
+			config: map[string]interface{}{
+				"accepter_tags": map[string]interface{}{"aws:Foo": "bar"},
+				"peer_region":   endpoints.UsEast1RegionID,
+			},
+			expectedErrorText: "`accepter_tags` must not contain keys with the reserved \"aws:\" prefix: aws:Foo",
+		},
+		"no reserved keys": {
+			config: map[string]interface{}{
+				"tags": map[string]interface{}{"Foo": "aws:bar", "Name": "test"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			config := map[string]interface{}{
+				"peer_vpc_id": "vpc-11111111",
+				"vpc_id":      "vpc-22222222",
+			}
+			for k, v := range testCase.config {
+				config[k] = v
+			}
+
+			_, err := tfec2.ResourceVPCPeeringConnection().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), meta)
+
+			if testCase.expectedErrorText == "" && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+
+			if testCase.expectedErrorText != "" && (err == nil || !strings.Contains(err.Error(), testCase.expectedErrorText)) {
+				t.Errorf("expected error containing %q, got: %v", testCase.expectedErrorText, err)
+			}
+		})
+	}
+}
+
+func TestResourceVPCPeeringConnectionDiffDefaultOptions(t *testing.T) {
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	defaultOptions := map[string]string{
+		"accepter.#": "1",
+		"accepter.0.allow_classic_link_to_remote_vpc": "false",
+		"accepter.0.allow_remote_vpc_dns_resolution":  "false",
+		"accepter.0.allow_vpc_to_remote_classic_link": "false",
+	}
+
+	testCases := map[string]struct {
+		stateOptions     map[string]string
+		configOptions    []interface{}
+		expectedOptsDiff bool
+	}{
+		"omitted block, no options in state": {},
+		"empty block, no options in state": {
+			configOptions: []interface{}{map[string]interface{}{}},
+		},
+		"default block, no options in state": {
+			configOptions: []interface{}{map[string]interface{}{
+				"allow_remote_vpc_dns_resolution": false,
+			}},
+		},
+		"omitted block, default options in state": {
+			stateOptions: defaultOptions,
+		},
+		"empty block, default options in state": {
+			stateOptions:  defaultOptions,
+			configOptions: []interface{}{map[string]interface{}{}},
+		},
+		"non-default block, no options in state": {
+			configOptions: []interface{}{map[string]interface{}{
+				"allow_remote_vpc_dns_resolution": true,
+			}},
+			expectedOptsDiff: true,
+		},
+		"non-default block, default options in state": {
+			stateOptions: defaultOptions,
+			configOptions: []interface{}{map[string]interface{}{
+				"allow_remote_vpc_dns_resolution": true,
+			}},
+			expectedOptsDiff: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			attributes := map[string]string{
+				"id":                     "pcx-12345678",
+				"accept_status":          ec2.VpcPeeringConnectionStateReasonCodeActive,
+				"manage_peering_options": "true",
+				"orientation":            "requester",
+				"peer_vpc_id":            "vpc-11111111",
+				"vpc_id":                 "vpc-22222222",
+			}
+			for k, v := range testCase.stateOptions {
+				attributes[k] = v
+			}
+
+			config := map[string]interface{}{
+				"peer_vpc_id": "vpc-11111111",
+				"vpc_id":      "vpc-22222222",
+			}
+			if testCase.configOptions != nil {
+				config["accepter"] = testCase.configOptions
+			}
+
+			diff, err := tfec2.ResourceVPCPeeringConnection().Diff(context.Background(), &terraform.InstanceState{ID: "pcx-12345678", Attributes: attributes}, terraform.NewResourceConfigRaw(config), meta)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var optsDiff bool
+			if diff != nil {
+				for k, v := range diff.Attributes {
+					if strings.HasPrefix(k, "accepter.") && !v.NewComputed {
+						optsDiff = true
+					}
+				}
+			}
+
+			if got, want := optsDiff, testCase.expectedOptsDiff; got != want {
+				t.Errorf("got accepter diff %t, expected %t: %v", got, want, diff)
+			}
+		})
+	}
+}
+
+func TestResourceVPCPeeringConnectionDiffAllowRemoteVPCDNSResolution(t *testing.T) {
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	testState := func(flag string, requesterDNSResolution string) *terraform.InstanceState {
+		attributes := map[string]string{
+			"id":                     "pcx-12345678",
+			"accept_status":          ec2.VpcPeeringConnectionStateReasonCodeActive,
+			"manage_peering_options": "true",
+			"orientation":            "requester",
+			"peer_vpc_id":            "vpc-11111111",
+			"requester.#":            "1",
+			"requester.0.allow_classic_link_to_remote_vpc": "false",
+			"requester.0.allow_remote_vpc_dns_resolution":  requesterDNSResolution,
+			"requester.0.allow_vpc_to_remote_classic_link": "false",
+			"vpc_id": "vpc-22222222",
+		}
+		if flag != "" {
+			attributes["allow_remote_vpc_dns_resolution"] = flag
+		}
+
+		return &terraform.InstanceState{
+			ID:         "pcx-12345678",
+			Attributes: attributes,
+		}
+	}
+
+	testCases := map[string]struct {
+		state             *terraform.InstanceState
+		flag              interface{}
+		expectedRequester string
+	}{
+		"enabled": {
+			state:             testState("", "false"),
+			flag:              true,
+			expectedRequester: "true",
+		},
+		"enabled unchanged": {
+			state: testState("true", "true"),
+			flag:  true,
+		},
+		"enabled drifted": {
+			state:             testState("true", "false"),
+			flag:              true,
+			expectedRequester: "true",
+		},
+		"removed": {
+			state:             testState("true", "true"),
+			expectedRequester: "false",
+		},
+		"omitted": {
+			state: testState("", "true"),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			config := map[string]interface{}{
+				"peer_vpc_id": "vpc-11111111",
+				"vpc_id":      "vpc-22222222",
+			}
+			if testCase.flag != nil {
+				config["allow_remote_vpc_dns_resolution"] = testCase.flag
+			}
+
+			diff, err := tfec2.ResourceVPCPeeringConnection().Diff(context.Background(), testCase.state, terraform.NewResourceConfigRaw(config), meta)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got string
+			if diff != nil {
+				if v := diff.Attributes["requester.0.allow_remote_vpc_dns_resolution"]; v != nil && v.Old != v.New {
+					got = v.New
+				}
+			}
+
+			if want := testCase.expectedRequester; got != want {
+				t.Errorf("got requester.0.allow_remote_vpc_dns_resolution diff %q, expected %q: %v", got, want, diff)
+			}
+		})
+	}
+
+	t.Run("conflicts with requester", func(t *testing.T) {
+		diags := tfec2.ResourceVPCPeeringConnection().Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+			"allow_remote_vpc_dns_resolution": true,
+			"peer_vpc_id":                     "vpc-11111111",
+			"requester": []interface{}{map[string]interface{}{
+				"allow_remote_vpc_dns_resolution": true,
+			}},
+			"vpc_id": "vpc-22222222",
+		}))
+
+		if !diags.HasError() {
+			t.Error("expected conflict error")
+		}
+	})
+}
+
+func TestResourceVPCPeeringConnectionDiffUnknownPeerVPCID(t *testing.T) {
+	// The value that the SDK's configuration shim uses to represent a value not known until apply,
+	// e.g. the ID of a VPC from a data source that can't be read during planning.
+	const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	testCases := map[string]struct {
+		state *terraform.InstanceState
+	}{
+		"create": {},
+		"update": {
+			state: &terraform.InstanceState{
+				ID: "pcx-12345678",
+				Attributes: map[string]string{
+					"id":                     "pcx-12345678",
+					"accept_status":          ec2.VpcPeeringConnectionStateReasonCodeActive,
+					"manage_peering_options": "true",
+					"orientation":            "requester",
+					"peer_vpc_id":            "vpc-11111111",
+					"vpc_id":                 "vpc-22222222",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			// With vpc_id set to the same VPC, the self-peering check can't be made until peer_vpc_id is known.
+			config := map[string]interface{}{
+				"peer_vpc_id": unknownValue,
+				"vpc_id":      "vpc-22222222",
+			}
+
+			diff, err := tfec2.ResourceVPCPeeringConnection().Diff(context.Background(), testCase.state, terraform.NewResourceConfigRaw(config), meta)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff == nil || diff.Attributes["peer_vpc_id"] == nil || !diff.Attributes["peer_vpc_id"].NewComputed {
+				t.Fatalf("expected peer_vpc_id to be unknown, got: %v", diff)
+			}
+
+			// A changed peer VPC can't be known to be the current accepter VPC, so the VPC Peering Connection is replaced.
+			if testCase.state != nil && !diff.Attributes["peer_vpc_id"].RequiresNew {
+				t.Errorf("expected peer_vpc_id to require replacement")
+			}
+		})
+	}
+}
+
+func TestResourceVPCPeeringConnectionDiffStandaloneOptionsOnly(t *testing.T) {
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+		VPCPeeringConnectionStandaloneOptionsOnly: true,
+	}
+
+	state := &terraform.InstanceState{
+		ID: "pcx-12345678",
+		Attributes: map[string]string{
+			"id":            "pcx-12345678",
+			"accept_status": ec2.VpcPeeringConnectionStateReasonCodeActive,
+			"accepter.#":    "1",
+			"accepter.0.allow_classic_link_to_remote_vpc": "false",
+			"accepter.0.allow_remote_vpc_dns_resolution":  "true",
+			"accepter.0.allow_vpc_to_remote_classic_link": "false",
+			"manage_peering_options":                      "true",
+			"orientation":                                 "requester",
+			"peer_vpc_id":                                 "vpc-11111111",
+			"vpc_id":                                      "vpc-22222222",
+		},
+	}
+
+	testCases := map[string]struct {
+		state             *terraform.InstanceState
+		accepter          []interface{}
+		expectedErrorText string
+	}{
+		"create omitted": {},
+		"create configured": {
+			accepter: []interface{}{map[string]interface{}{
+				"allow_remote_vpc_dns_resolution": true,
+			}},
+			expectedErrorText: "`accepter` is read-only",
+		},
+		"update omitted": {
+			state: state,
+		},
+		"update unchanged": {
+			state: state,
+			accepter: []interface{}{map[string]interface{}{
+				"allow_remote_vpc_dns_resolution": true,
+			}},
+		},
+		"update changed": {
+			state: state,
+			accepter: []interface{}{map[string]interface{}{
+				"allow_remote_vpc_dns_resolution": false,
+			}},
+			expectedErrorText: "`accepter` is read-only",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			config := map[string]interface{}{
+				"peer_vpc_id": "vpc-11111111",
+				"vpc_id":      "vpc-22222222",
+			}
+			if testCase.accepter != nil {
+				config["accepter"] = testCase.accepter
+			}
+
+			diff, err := tfec2.ResourceVPCPeeringConnection().Diff(context.Background(), testCase.state, terraform.NewResourceConfigRaw(config), meta)
+
+			if testCase.expectedErrorText != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectedErrorText) {
+					t.Errorf("expected error containing %q, got: %v", testCase.expectedErrorText, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff != nil {
+				for k, v := range diff.Attributes {
+					if strings.HasPrefix(k, "accepter.") && !v.NewComputed {
+						t.Errorf("unexpected diff for %s: %#v", k, v)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestResourceVPCPeeringConnectionDiffPeerRegionSameRegion(t *testing.T) {
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	// A peer_region equal to the provider's region is a same-region VPC Peering Connection.
+	config := map[string]interface{}{
+		"accepter": []interface{}{map[string]interface{}{
+			"allow_classic_link_to_remote_vpc": true,
+		}},
+		"auto_accept": true,
+		"peer_region": endpoints.UsWest2RegionID,
+		"peer_vpc_id": "vpc-11111111",
+		"vpc_id":      "vpc-22222222",
+	}
+
+	if _, err := tfec2.ResourceVPCPeeringConnection().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), meta); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func TestResourceVPCPeeringConnectionReadFailed(t *testing.T) {
+	// A VPC Peering Connection that failed, e.g. because of overlapping CIDR blocks, is removed from state
+	// so that the next apply creates a new one.
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		if got, want := r.Operation.Name, "DescribeVpcPeeringConnections"; got != want {
+			t.Errorf("unexpected operation: %s", got)
+		}
+
+		r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{{
+			Status: &ec2.VpcPeeringConnectionStateReason{
+				Code:    aws.String(ec2.VpcPeeringConnectionStateReasonCodeFailed),
+				Message: aws.String("Overlapping CIDR range"),
+			},
+			VpcPeeringConnectionId: aws.String("pcx-12345678"),
+		}}
+	})
+
+	r := tfec2.ResourceVPCPeeringConnection()
+	d := r.Data(&terraform.InstanceState{
+		ID: "pcx-12345678",
+		Attributes: map[string]string{
+			"id":          "pcx-12345678",
+			"auto_accept": "true",
+			"peer_vpc_id": "vpc-11111111",
+			"vpc_id":      "vpc-22222222",
+		},
+	})
+
+	if diags := r.ReadWithoutTimeout(context.Background(), d, &conns.AWSClient{EC2Conn: conn}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Id(); got != "" {
+		t.Errorf("got ID %q, expected VPC Peering Connection to be removed from state", got)
+	}
+}
+
+func TestResourceVPCPeeringConnectionReadDNSResolutionEnabled(t *testing.T) {
+	testCases := []struct {
+		name              string
+		accepterOptions   *ec2.VpcPeeringConnectionOptionsDescription
+		requesterOptions  *ec2.VpcPeeringConnectionOptionsDescription
+		expectedIsEnabled bool
+	}{
+		{
+			name:              "both directions",
+			accepterOptions:   &ec2.VpcPeeringConnectionOptionsDescription{AllowDnsResolutionFromRemoteVpc: aws.Bool(true)},
+			requesterOptions:  &ec2.VpcPeeringConnectionOptionsDescription{AllowDnsResolutionFromRemoteVpc: aws.Bool(true)},
+			expectedIsEnabled: true,
+		},
+		{
+			name:              "one direction",
+			accepterOptions:   &ec2.VpcPeeringConnectionOptionsDescription{AllowDnsResolutionFromRemoteVpc: aws.Bool(true)},
+			requesterOptions:  &ec2.VpcPeeringConnectionOptionsDescription{AllowDnsResolutionFromRemoteVpc: aws.Bool(false)},
+			expectedIsEnabled: false,
+		},
+		{
+			// Options aren't returned for a VPC Peering Connection that is pending acceptance.
+			name:              "no options",
+			expectedIsEnabled: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+				r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{testVPCPeeringConnection("111111111111", ec2.VpcPeeringConnectionStateReasonCodeActive, testCase.accepterOptions, testCase.requesterOptions)}
+			})
+			meta := &conns.AWSClient{
+				AccountID: "111111111111",
+				EC2Conn:   conn,
+				Partition: endpoints.AwsPartitionID,
+				Region:    endpoints.UsWest2RegionID,
+			}
+
+			r := tfec2.ResourceVPCPeeringConnection()
+			d := r.Data(&terraform.InstanceState{
+				ID: "pcx-12345678",
+				Attributes: map[string]string{
+					"id":          "pcx-12345678",
+					"peer_vpc_id": "vpc-11111111",
+					"vpc_id":      "vpc-22222222",
+				},
+			})
+
+			if diags := r.ReadWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got, want := d.Get("dns_resolution_enabled").(bool), testCase.expectedIsEnabled; got != want {
+				t.Errorf("got dns_resolution_enabled %t, expected %t", got, want)
+			}
+		})
+	}
+}
+
+func TestResourceVPCPeeringConnectionReadExpirationTime(t *testing.T) {
+	expirationTime := time.Date(2022, time.August, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		statusCode string
+		want       string
+	}{
+		{
+			name:       "pending acceptance",
+			statusCode: ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance,
+			want:       "2022-08-01T12:00:00Z",
+		},
+		{
+			// The API may still return the expiration time of a VPC Peering Connection that has since been accepted.
+			name:       "active",
+			statusCode: ec2.VpcPeeringConnectionStateReasonCodeActive,
+			want:       "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+				vpcPeeringConnection := testVPCPeeringConnection("222222222222", testCase.statusCode, nil, nil)
+				vpcPeeringConnection.ExpirationTime = aws.Time(expirationTime)
+				r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{vpcPeeringConnection}
+			})
+			meta := &conns.AWSClient{
+				AccountID: "111111111111",
+				EC2Conn:   conn,
+				Partition: endpoints.AwsPartitionID,
+				Region:    endpoints.UsWest2RegionID,
+			}
+
+			r := tfec2.ResourceVPCPeeringConnection()
+			d := r.Data(&terraform.InstanceState{
+				ID: "pcx-12345678",
+				Attributes: map[string]string{
+					"id":              "pcx-12345678",
+					"expiration_time": "2022-07-31T12:00:00Z",
+					"peer_vpc_id":     "vpc-11111111",
+					"vpc_id":          "vpc-22222222",
+				},
+			})
+
+			if diags := r.ReadWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got := d.Get("expiration_time").(string); got != testCase.want {
+				t.Errorf("got expiration_time %q, expected %q", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestResourceVPCPeeringConnectionUpdateAccepterAssumeRoleEndpoints(t *testing.T) {
+	t.Parallel()
+
+	var accepted bool
+	ec2Server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("error parsing request: %s", err)
+		}
+
+		switch action := r.Form.Get("Action"); action {
+		case "AcceptVpcPeeringConnection":
+			accepted = true
+
+			fmt.Fprint(w, `<AcceptVpcPeeringConnectionResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/"><requestId>1</requestId></AcceptVpcPeeringConnectionResponse>`)
+		case "DescribeVpcPeeringConnections":
+			statusCode := ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance
+			if accepted {
+				statusCode = ec2.VpcPeeringConnectionStateReasonCodeActive
+			}
+
+			fmt.Fprint(w, testVPCPeeringConnectionEndpointDescribeResponse(statusCode))
+		default:
+			t.Errorf("unexpected action at EC2 endpoint: %s", action)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ec2Server.Close()
+
+	var assumeRoles int
+	stsServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("error parsing request: %s", err)
+		}
+
+		if got, want := r.Form.Get("Action"), "AssumeRole"; got != want {
+			t.Errorf("unexpected action at STS endpoint: %s", got)
+		}
+
+		assumeRoles++
+
+		fmt.Fprint(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/"><AssumeRoleResult><Credentials>`+
+			`<AccessKeyId>AKIAACCEPTER</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken>`+
+			`<Expiration>2099-01-01T00:00:00Z</Expiration></Credentials></AssumeRoleResult></AssumeRoleResponse>`)
+	}))
+	defer stsServer.Close()
+
+	stsConn := sts.New(session.Must(session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKIAREQUESTER", "secret", ""),
+		Endpoint:    aws.String(stsServer.URL),
+		Region:      aws.String(endpoints.UsWest2RegionID),
+	})))
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		EC2Conn:   testVPCPeeringConnectionEndpointConn(t, ec2Server.URL),
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+		STSConn:   stsConn,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnection()
+	d := r.Data(&terraform.InstanceState{
+		ID: "pcx-12345678",
+		Attributes: map[string]string{
+			"id":                              "pcx-12345678",
+			"accepter_assume_role.#":          "1",
+			"accepter_assume_role.0.role_arn": "arn:aws:iam::222222222222:role/accepter", //lintignore:AWSAT005
+			"auto_accept":                     "true",
+			"manage_peering_options":          "false",
+			"orientation":                     "requester",
+			"peer_owner_id":                   "222222222222",
+			"peer_vpc_id":                     "vpc-11111111",
+			"vpc_id":                          "vpc-22222222",
+		},
+	})
+
+	if diags := r.UpdateWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !accepted {
+		t.Error("expected VPC Peering Connection to be accepted at the EC2 endpoint")
+	}
+
+	if got, want := assumeRoles, 1; got != want {
+		t.Errorf("got %d AssumeRole calls at the STS endpoint, expected %d", got, want)
+	}
+}
+
+func TestResourceVPCPeeringConnectionUpdateAccepterAssumeRoleNoSTSClient(t *testing.T) {
+	t.Parallel()
+
+	var accepted bool
+	var stsHosts []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("error parsing request: %s", err)
+		}
+
+		switch action := r.Form.Get("Action"); action {
+		case "AcceptVpcPeeringConnection":
+			accepted = true
+
+			fmt.Fprint(w, `<AcceptVpcPeeringConnectionResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/"><requestId>1</requestId></AcceptVpcPeeringConnectionResponse>`)
+		case "AssumeRole":
+			stsHosts = append(stsHosts, r.URL.Host)
+
+			fmt.Fprint(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/"><AssumeRoleResult><Credentials>`+
+				`<AccessKeyId>AKIAACCEPTER</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken>`+
+				`<Expiration>2099-01-01T00:00:00Z</Expiration></Credentials></AssumeRoleResult></AssumeRoleResponse>`)
+		case "DescribeVpcPeeringConnections":
+			statusCode := ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance
+			if accepted {
+				statusCode = ec2.VpcPeeringConnectionStateReasonCodeActive
+			}
+
+			fmt.Fprint(w, testVPCPeeringConnectionEndpointDescribeResponse(statusCode))
+		default:
+			t.Errorf("unexpected action: %s", action)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	// Requests to any endpoint, including the default STS endpoint, are proxied to the local server.
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	proxyURL, err := url.Parse(server.URL)
+
+	if err != nil {
+		t.Fatalf("error parsing URL: %s", err)
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKIAREQUESTER", "secret", ""),
+		DisableSSL:  aws.Bool(true),
+		HTTPClient:  &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(proxyURL)}},
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String(endpoints.UsWest2RegionID),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	// The provider's STS client isn't configured.
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		EC2Conn:   ec2.New(sess),
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnection()
+	d := r.Data(&terraform.InstanceState{
+		ID: "pcx-12345678",
+		Attributes: map[string]string{
+			"id":                              "pcx-12345678",
+			"accepter_assume_role.#":          "1",
+			"accepter_assume_role.0.role_arn": "arn:aws:iam::222222222222:role/accepter", //lintignore:AWSAT005
+			"auto_accept":                     "true",
+			"manage_peering_options":          "false",
+			"orientation":                     "requester",
+			"peer_owner_id":                   "222222222222",
+			"peer_vpc_id":                     "vpc-11111111",
+			"vpc_id":                          "vpc-22222222",
+		},
+	})
+
+	if diags := r.UpdateWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if !accepted {
+		t.Error("expected VPC Peering Connection to be accepted")
+	}
+
+	// The role is assumed using the default STS endpoint.
+	if got, want := stsHosts, []string{"sts.amazonaws.com"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got AssumeRole calls at %v, expected %v", got, want)
+	}
+}
+
+func TestResourceVPCPeeringConnectionUpdateOptionsAfterAcceptance(t *testing.T) {
+	// The configured options are in state, but weren't applied while the VPC Peering Connection was pending acceptance.
+	state := &terraform.InstanceState{
+		ID: "pcx-12345678",
+		Attributes: map[string]string{
+			"id":         "pcx-12345678",
+			"accepter.#": "1",
+			"accepter.0.allow_classic_link_to_remote_vpc": "false",
+			"accepter.0.allow_remote_vpc_dns_resolution":  "true",
+			"accepter.0.allow_vpc_to_remote_classic_link": "false",
+			"manage_peering_options":                      "true",
+			"orientation":                                 "requester",
+			"peer_vpc_id":                                 "vpc-11111111",
+			"vpc_id":                                      "vpc-22222222",
+		},
+	}
+
+	var modifyInputs []*ec2.ModifyVpcPeeringConnectionOptionsInput
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		switch r.Operation.Name {
+		case "DescribeVpcPeeringConnections":
+			r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{testVPCPeeringConnection("111111111111", ec2.VpcPeeringConnectionStateReasonCodeActive, testVPCPeeringConnectionOptions(len(modifyInputs) > 0), testVPCPeeringConnectionOptions(false))}
+		case "ModifyVpcPeeringConnectionOptions":
+			modifyInputs = append(modifyInputs, r.Params.(*ec2.ModifyVpcPeeringConnectionOptionsInput))
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+	conn.Config.Region = aws.String(endpoints.UsWest2RegionID)
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		EC2Conn:   conn,
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnection()
+	d := r.Data(state)
+
+	if diags := r.UpdateWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := len(modifyInputs), 1; got != want {
+		t.Fatalf("got %d ModifyVpcPeeringConnectionOptions calls, expected %d", got, want)
+	}
+
+	if input := modifyInputs[0]; input.AccepterPeeringConnectionOptions == nil || !aws.BoolValue(input.AccepterPeeringConnectionOptions.AllowDnsResolutionFromRemoteVpc) {
+		t.Errorf("expected accepter DNS resolution to be enabled, got: %s", input)
+	} else if input.RequesterPeeringConnectionOptions != nil {
+		t.Errorf("unexpected requester options: %s", input.RequesterPeeringConnectionOptions)
+	}
+
+	if got, want := d.Get("peering_options_managed_by").(string), "inline"; got != want {
+		t.Errorf("got peering_options_managed_by %q, expected %q", got, want)
+	}
+}
+
+func TestResourceVPCPeeringConnectionUpdateClassicLinkOptionDisabled(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "pcx-12345678",
+		Attributes: map[string]string{
+			"id":                     "pcx-12345678",
+			"accept_status":          ec2.VpcPeeringConnectionStateReasonCodeActive,
+			"manage_peering_options": "true",
+			"orientation":            "requester",
+			"peer_vpc_id":            "vpc-11111111",
+			"requester.#":            "1",
+			"requester.0.allow_classic_link_to_remote_vpc": "true",
+			"requester.0.allow_remote_vpc_dns_resolution":  "false",
+			"requester.0.allow_vpc_to_remote_classic_link": "false",
+			"vpc_id": "vpc-22222222",
+		},
+	}
+
+	var modifyInputs []*ec2.ModifyVpcPeeringConnectionOptionsInput
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		switch r.Operation.Name {
+		case "DescribeVpcPeeringConnections":
+			// ClassicLink is enabled on the legacy account until it's disabled.
+			requesterOptions := testVPCPeeringConnectionOptions(false)
+			requesterOptions.AllowEgressFromLocalClassicLinkToRemoteVpc = aws.Bool(len(modifyInputs) == 0)
+
+			r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{testVPCPeeringConnection("111111111111", ec2.VpcPeeringConnectionStateReasonCodeActive, nil, requesterOptions)}
+		case "ModifyVpcPeeringConnectionOptions":
+			modifyInputs = append(modifyInputs, r.Params.(*ec2.ModifyVpcPeeringConnectionOptionsInput))
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+	conn.Config.Region = aws.String(endpoints.UsWest2RegionID)
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		EC2Conn:   conn,
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnection()
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"peer_vpc_id": "vpc-11111111",
+		"requester": []interface{}{map[string]interface{}{
+			"allow_classic_link_to_remote_vpc": false,
+		}},
+		// Waiting for the option to be disabled would otherwise exceed the update timeout.
+		"timeouts": map[string]interface{}{
+			"update": "5s",
+		},
+		"vpc_id": "vpc-22222222",
+	}), meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error diffing: %s", err)
+	}
+
+	if _, diags := r.Apply(context.Background(), state, diff, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := len(modifyInputs), 1; got != want {
+		t.Fatalf("got %d ModifyVpcPeeringConnectionOptions calls, expected %d", got, want)
+	}
+
+	if options := modifyInputs[0].RequesterPeeringConnectionOptions; options == nil || options.AllowEgressFromLocalClassicLinkToRemoteVpc == nil || aws.BoolValue(options.AllowEgressFromLocalClassicLinkToRemoteVpc) {
+		t.Errorf("expected requester ClassicLink to remote VPC to be disabled, got: %s", options)
+	} else if options.AllowEgressFromLocalVpcToRemoteClassicLink != nil {
+		t.Errorf("unexpected requester VPC to remote ClassicLink option: %s", options)
+	}
+}
+
+func TestResourceVPCPeeringConnectionUpdateStandaloneOptionsOnly(t *testing.T) {
+	// The options in state differ from those in AWS, but are only modified using aws_vpc_peering_connection_options.
+	state := &terraform.InstanceState{
+		ID: "pcx-12345678",
+		Attributes: map[string]string{
+			"id":         "pcx-12345678",
+			"accepter.#": "1",
+			"accepter.0.allow_classic_link_to_remote_vpc": "false",
+			"accepter.0.allow_remote_vpc_dns_resolution":  "true",
+			"accepter.0.allow_vpc_to_remote_classic_link": "false",
+			"manage_peering_options":                      "true",
+			"orientation":                                 "requester",
+			"peer_vpc_id":                                 "vpc-11111111",
+			"vpc_id":                                      "vpc-22222222",
+		},
+	}
+
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		switch r.Operation.Name {
+		case "DescribeVpcPeeringConnections":
+			r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{testVPCPeeringConnection("111111111111", ec2.VpcPeeringConnectionStateReasonCodeActive, testVPCPeeringConnectionOptions(false), testVPCPeeringConnectionOptions(false))}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+	conn.Config.Region = aws.String(endpoints.UsWest2RegionID)
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		EC2Conn:   conn,
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+		VPCPeeringConnectionStandaloneOptionsOnly: true,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnection()
+	d := r.Data(state)
+
+	if diags := r.UpdateWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("peering_options_managed_by").(string); got != "" {
+		t.Errorf("got peering_options_managed_by %q, expected none", got)
+	}
+}
+
+func TestResourceVPCPeeringConnectionUpdateAutoAcceptProvisioning(t *testing.T) {
+	t.Parallel()
+
+	state := &terraform.InstanceState{
+		ID: "pcx-12345678",
+		Attributes: map[string]string{
+			"id":                     "pcx-12345678",
+			"auto_accept":            "true",
+			"manage_peering_options": "false",
+			"orientation":            "requester",
+			"peer_vpc_id":            "vpc-11111111",
+			"vpc_id":                 "vpc-22222222",
+		},
+	}
+
+	var describes, accepts int
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		switch r.Operation.Name {
+		case "DescribeVpcPeeringConnections":
+			describes++
+
+			// The VPC Peering Connection is still being provisioned when the update starts.
+			statusCode := ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance
+			if describes == 1 {
+				statusCode = ec2.VpcPeeringConnectionStateReasonCodeProvisioning
+			} else if accepts > 0 {
+				statusCode = ec2.VpcPeeringConnectionStateReasonCodeActive
+			}
+
+			r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{testVPCPeeringConnection("111111111111", statusCode, nil, nil)}
+		case "AcceptVpcPeeringConnection":
+			if describes < 2 {
+				t.Errorf("VPC Peering Connection accepted while provisioning")
+			}
+
+			accepts++
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+	conn.Config.Region = aws.String(endpoints.UsWest2RegionID)
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		EC2Conn:   conn,
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnection()
+	d := r.Data(state)
+
+	if diags := r.UpdateWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := accepts, 1; got != want {
+		t.Errorf("got %d AcceptVpcPeeringConnection calls, expected %d", got, want)
+	}
+
+	if got, want := d.Get("accept_status").(string), ec2.VpcPeeringConnectionStateReasonCodeActive; got != want {
+		t.Errorf("got accept_status %q, expected %q", got, want)
+	}
+}
+
+func TestResourceVPCPeeringConnectionUpdateOptionsNotActive(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "pcx-12345678",
+		Attributes: map[string]string{
+			"id":          "pcx-12345678",
+			"requester.#": "1",
+			"requester.0.allow_classic_link_to_remote_vpc": "false",
+			"requester.0.allow_remote_vpc_dns_resolution":  "false",
+			"requester.0.allow_vpc_to_remote_classic_link": "false",
+			"manage_peering_options":                       "true",
+			"orientation":                                  "requester",
+			"peer_vpc_id":                                  "vpc-11111111",
+			"vpc_id":                                       "vpc-22222222",
+		},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"requester.0.allow_remote_vpc_dns_resolution": {Old: "false", New: "true"},
+		},
+	}
+
+	testCases := map[string]struct {
+		accepterOwnerID   string
+		expectedErrorText string
+	}{
+		"same account": {
+			accepterOwnerID:   "111111111111",
+			expectedErrorText: "Please set the `auto_accept` attribute to `true`",
+		},
+		"cross-account": {
+			accepterOwnerID:   "222222222222",
+			expectedErrorText: "must be accepted in the accepter's account (222222222222)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+				switch r.Operation.Name {
+				case "DescribeVpcPeeringConnections":
+					r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{testVPCPeeringConnection(testCase.accepterOwnerID, ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance, nil, nil)}
+				default:
+					t.Errorf("unexpected operation: %s", r.Operation.Name)
+				}
+			})
+			conn.Config.Region = aws.String(endpoints.UsWest2RegionID)
+			meta := &conns.AWSClient{
+				AccountID: "111111111111",
+				EC2Conn:   conn,
+				Partition: endpoints.AwsPartitionID,
+				Region:    endpoints.UsWest2RegionID,
+			}
+
+			r := tfec2.ResourceVPCPeeringConnection()
+			d, err := schema.InternalMap(r.Schema).Data(state, diff)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			diags := r.UpdateWithoutTimeout(context.Background(), d, meta)
+
+			if !diags.HasError() {
+				t.Fatalf("expected error")
+			}
+
+			if summary := diags[0].Summary; !strings.Contains(summary, "is not active (current status: pending-acceptance)") || !strings.Contains(summary, testCase.expectedErrorText) {
+				t.Errorf("unexpected error: %s", summary)
+			}
+		})
+	}
+}
+
+func TestResourceVPCPeeringConnectionUpdateIgnoreChangesOptions(t *testing.T) {
+	// DNS resolution was enabled for the accepter by this resource, then disabled out of band by the accepter's account.
+	state := &terraform.InstanceState{
+		ID: "pcx-12345678",
+		Attributes: map[string]string{
+			"id":         "pcx-12345678",
+			"accepter.#": "1",
+			"accepter.0.allow_classic_link_to_remote_vpc": "false",
+			"accepter.0.allow_remote_vpc_dns_resolution":  "true",
+			"accepter.0.allow_vpc_to_remote_classic_link": "false",
+			"manage_peering_options":                      "true",
+			"orientation":                                 "requester",
+			"peer_vpc_id":                                 "vpc-11111111",
+			"vpc_id":                                      "vpc-22222222",
+		},
+	}
+
+	var modifies int
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		switch r.Operation.Name {
+		case "CreateTags":
+		case "DescribeVpcPeeringConnections":
+			r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{testVPCPeeringConnection("111111111111", ec2.VpcPeeringConnectionStateReasonCodeActive, testVPCPeeringConnectionOptions(false), testVPCPeeringConnectionOptions(false))}
+		case "ModifyVpcPeeringConnectionOptions":
+			modifies++
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+	conn.Config.Region = aws.String(endpoints.UsWest2RegionID)
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		EC2Conn:   conn,
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnection()
+	d := r.Data(state)
+
+	if diags := r.ReadWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error reading: %v", diags)
+	}
+
+	state = d.State()
+
+	// With `lifecycle { ignore_changes = [accepter] }`, Terraform plans the refreshed accepter options
+	// in place of the configured ones. Only the tags are changed.
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"accepter": []interface{}{map[string]interface{}{
+			"allow_classic_link_to_remote_vpc": false,
+			"allow_remote_vpc_dns_resolution":  false,
+			"allow_vpc_to_remote_classic_link": false,
+		}},
+		"peer_vpc_id": "vpc-11111111",
+		"tags": map[string]interface{}{
+			"Name": "test",
+		},
+		"vpc_id": "vpc-22222222",
+	}), meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error diffing: %s", err)
+	}
+
+	d, err = schema.InternalMap(r.Schema).Data(state, diff)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if d.HasChange("accepter") {
+		t.Error("expected no accepter options change")
+	}
+
+	if diags := r.UpdateWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error updating: %v", diags)
+	}
+
+	if modifies != 0 {
+		t.Errorf("got %d ModifyVpcPeeringConnectionOptions calls, expected none", modifies)
+	}
+}
+
+func TestResourceVPCPeeringConnectionUpdateTagsRetry(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "pcx-12345678",
+		Attributes: map[string]string{
+			"id":                     "pcx-12345678",
+			"manage_peering_options": "true",
+			"orientation":            "requester",
+			"peer_vpc_id":            "vpc-11111111",
+			"vpc_id":                 "vpc-22222222",
+		},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"tags_all.%":    {Old: "0", New: "1"},
+			"tags_all.Name": {Old: "", New: "test"},
+		},
+	}
+
+	var createTags int
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		switch r.Operation.Name {
+		case "DescribeVpcPeeringConnections":
+			r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{{
+				AccepterVpcInfo:        &ec2.VpcPeeringConnectionVpcInfo{VpcId: aws.String("vpc-11111111")},
+				RequesterVpcInfo:       &ec2.VpcPeeringConnectionVpcInfo{VpcId: aws.String("vpc-22222222")},
+				Status:                 &ec2.VpcPeeringConnectionStateReason{Code: aws.String(ec2.VpcPeeringConnectionStateReasonCodeActive)},
+				VpcPeeringConnectionId: aws.String("pcx-12345678"),
+			}}
+		case "CreateTags":
+			createTags++
+			if createTags == 1 {
+				r.Error = awserr.New("InvalidParameterException", "tag policy not yet evaluated", nil)
+			}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		EC2Conn:   conn,
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnection()
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diags := r.UpdateWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := createTags, 2; got != want {
+		t.Errorf("got %d CreateTags calls, expected %d", got, want)
+	}
+}
+
+func TestResourceVPCPeeringConnectionUpdateAcceptRetry(t *testing.T) {
+	t.Parallel()
+
+	state := &terraform.InstanceState{
+		ID: "pcx-12345678",
+		Attributes: map[string]string{
+			"id":                     "pcx-12345678",
+			"auto_accept":            "true",
+			"manage_peering_options": "false",
+			"orientation":            "requester",
+			"peer_vpc_id":            "vpc-11111111",
+			"vpc_id":                 "vpc-22222222",
+		},
+	}
+
+	var accepts int
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		switch r.Operation.Name {
+		case "DescribeVpcPeeringConnections":
+			statusCode := ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance
+			if accepts > 1 {
+				statusCode = ec2.VpcPeeringConnectionStateReasonCodeActive
+			}
+
+			r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{testVPCPeeringConnection("111111111111", statusCode, nil, nil)}
+		case "AcceptVpcPeeringConnection":
+			accepts++
+			if accepts == 1 {
+				r.Error = awserr.New("InvalidStateTransition", "Invalid state transition for pcx-12345678, attempted to transition from initiating-request to active", nil)
+			}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+	conn.Config.Region = aws.String(endpoints.UsWest2RegionID)
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		EC2Conn:   conn,
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnection()
+	d, err := schema.InternalMap(r.Schema).Data(state, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diags := r.UpdateWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := accepts, 2; got != want {
+		t.Errorf("got %d AcceptVpcPeeringConnection calls, expected %d", got, want)
+	}
+
+	if got, want := d.Get("accept_status").(string), ec2.VpcPeeringConnectionStateReasonCodeActive; got != want {
+		t.Errorf("got accept_status %q, expected %q", got, want)
+	}
+}
+
+func TestResourceVPCPeeringConnectionUpdateAcceptTimeout(t *testing.T) {
+	t.Parallel()
+
+	state := &terraform.InstanceState{
+		ID: "pcx-12345678",
+		Attributes: map[string]string{
+			"id":                     "pcx-12345678",
+			"accept_status":          ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance,
+			"auto_accept":            "false",
+			"manage_peering_options": "false",
+			"orientation":            "requester",
+			"peer_vpc_id":            "vpc-11111111",
+			"vpc_id":                 "vpc-22222222",
+		},
+	}
+
+	var accepts int
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		switch r.Operation.Name {
+		case "DescribeVpcPeeringConnections":
+			statusCode := ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance
+			if accepts > 1 {
+				statusCode = ec2.VpcPeeringConnectionStateReasonCodeActive
+			}
+
+			r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{testVPCPeeringConnection("111111111111", statusCode, nil, nil)}
+		case "AcceptVpcPeeringConnection":
+			accepts++
+			if accepts == 1 {
+				r.Error = awserr.New("InvalidStateTransition", "Invalid state transition for pcx-12345678, attempted to transition from initiating-request to active", nil)
+			}
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+	conn.Config.Region = aws.String(endpoints.UsWest2RegionID)
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		EC2Conn:   conn,
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnection()
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"auto_accept":            true,
+		"manage_peering_options": false,
+		"peer_vpc_id":            "vpc-11111111",
+		// Accepting on update is bounded by the update timeout, not the create timeout.
+		"timeouts": map[string]interface{}{
+			"create": "1ns",
+		},
+		"vpc_id": "vpc-22222222",
+	}), meta)
+
+	if err != nil {
+		t.Fatalf("unexpected error diffing: %s", err)
+	}
+
+	if _, diags := r.Apply(context.Background(), state, diff, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := accepts, 2; got != want {
+		t.Errorf("got %d AcceptVpcPeeringConnection calls, expected %d", got, want)
+	}
+}
+
+func TestResourceVPCPeeringConnectionUpdateAcceptWaitsForActive(t *testing.T) {
+	t.Parallel()
+
+	state := &terraform.InstanceState{
+		ID: "pcx-12345678",
+		Attributes: map[string]string{
+			"id":                     "pcx-12345678",
+			"auto_accept":            "true",
+			"manage_peering_options": "false",
+			"orientation":            "requester",
+			"peer_vpc_id":            "vpc-11111111",
+			"vpc_id":                 "vpc-22222222",
+		},
+	}
+
+	// The VPC Peering Connection remains pending acceptance, then provisioning, for a while after it is accepted.
+	statusCodesAfterAccept := []string{
+		ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance,
+		ec2.VpcPeeringConnectionStateReasonCodeProvisioning,
+		ec2.VpcPeeringConnectionStateReasonCodeActive,
+	}
+
+	var accepted bool
+	var describesAfterAccept int
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		switch r.Operation.Name {
+		case "DescribeVpcPeeringConnections":
+			statusCode := ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance
+			if accepted {
+				statusCode = statusCodesAfterAccept[len(statusCodesAfterAccept)-1]
+				if describesAfterAccept < len(statusCodesAfterAccept) {
+					statusCode = statusCodesAfterAccept[describesAfterAccept]
+				}
+				describesAfterAccept++
+			}
+
+			r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{testVPCPeeringConnection("111111111111", statusCode, nil, nil)}
+		case "AcceptVpcPeeringConnection":
+			accepted = true
+		default:
+			t.Errorf("unexpected operation: %s", r.Operation.Name)
+		}
+	})
+	conn.Config.Region = aws.String(endpoints.UsWest2RegionID)
+	meta := &conns.AWSClient{
+		AccountID: "111111111111",
+		EC2Conn:   conn,
+		Partition: endpoints.AwsPartitionID,
+		Region:    endpoints.UsWest2RegionID,
+	}
+
+	r := tfec2.ResourceVPCPeeringConnection()
+	d, err := schema.InternalMap(r.Schema).Data(state, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diags := r.UpdateWithoutTimeout(context.Background(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := describesAfterAccept, len(statusCodesAfterAccept)+1; got < want {
+		t.Errorf("got %d describes after accepting, expected at least %d", got, want)
+	}
+
+	if got, want := d.Get("accept_status").(string), ec2.VpcPeeringConnectionStateReasonCodeActive; got != want {
+		t.Errorf("got accept_status %q, expected %q", got, want)
+	}
+}
+
+func TestAccVPCPeeringConnection_basic(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "accepter_cidr_block", "10.1.0.0/16"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`vpc-peering-connection/pcx-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "accepter_cidr_blocks.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "accepter_cidr_blocks.0", "10.1.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "accepter_ipv6_cidr_blocks.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "accepter_region", acctest.Region()),
+					resource.TestMatchResourceAttr(resourceName, "description_json", regexp.MustCompile(`"VpcPeeringConnectionId":"pcx-.+"`)),
+					resource.TestCheckResourceAttr(resourceName, "is_cross_account", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_cross_region", "false"),
+					resource.TestCheckResourceAttr(resourceName, "requester_cidr_block", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "requester_cidr_blocks.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "requester_cidr_blocks.0", "10.0.0.0/16"),
+					resource.TestCheckResourceAttr(resourceName, "requester_ipv6_cidr_blocks.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "requester_region", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "vpc_peering_connection_id", resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"auto_accept",
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccVPCPeeringConnectionImportStateIdFunc(resourceName, "requester"),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"auto_accept",
+				},
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_createBeforeDestroy(t *testing.T) {
+	var v1, v2 ec2.VpcPeeringConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+	routeResourceName := "aws_route.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionConfig_createBeforeDestroy(rName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v1),
+					resource.TestCheckResourceAttrPair(resourceName, "peer_vpc_id", "aws_vpc.peer.0", "id"),
+					resource.TestCheckResourceAttrPair(routeResourceName, "vpc_peering_connection_id", resourceName, "id"),
+				),
+			},
+			{
+				// The replacement VPC Peering Connection is created, and the route switched to it, before the original is deleted.
+				Config: testAccVPCPeeringConnectionConfig_createBeforeDestroy(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v2),
+					testAccCheckVPCPeeringConnectionRecreated(&v1, &v2),
+					resource.TestCheckResourceAttrPair(resourceName, "peer_vpc_id", "aws_vpc.peer.1", "id"),
+					resource.TestCheckResourceAttrPair(routeResourceName, "vpc_peering_connection_id", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_ipv6(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionConfig_ipv6(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "accepter_ipv6_cidr_blocks.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "requester_ipv6_cidr_blocks.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "requester_ipv6_cidr_blocks.0", "aws_vpc.test", "ipv6_cidr_block"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_importAccepterOrientation(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "orientation", "requester"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccVPCPeeringConnectionImportStateIdFunc(resourceName, "accepter"),
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					if len(s) != 1 {
+						return fmt.Errorf("expected 1 state: %#v", s)
+					}
+
+					rs := s[0]
+
+					if got, want := rs.Attributes["orientation"], "accepter"; got != want {
+						return fmt.Errorf("expected orientation %q, got %q", want, got)
+					}
+
+					if got, want := rs.Attributes["vpc_id"], aws.StringValue(v.AccepterVpcInfo.VpcId); got != want {
+						return fmt.Errorf("expected vpc_id %q, got %q", want, got)
+					}
+
+					if got, want := rs.Attributes["peer_vpc_id"], aws.StringValue(v.RequesterVpcInfo.VpcId); got != want {
+						return fmt.Errorf("expected peer_vpc_id %q, got %q", want, got)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_disappears(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfec2.ResourceVPCPeeringConnection(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_nameTag(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionConfig_tags1(rName, "Name", rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					func(s *terraform.State) error {
+						// The Name tag is applied on create, so it's visible on the VPC Peering Connection itself.
+						if got := tfec2.KeyValueTags(v.Tags).IgnoreAWS().Map()["Name"]; got != rName {
+							return fmt.Errorf("EC2 VPC Peering Connection Name tag is %q, expected %q", got, rName)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_tags(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"auto_accept",
+				},
+			},
+			{
+				Config: testAccVPCPeeringConnectionConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccVPCPeeringConnectionConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_DefaultTags_import(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccVPCPeeringConnectionConfig_tags1(rName, "key1", "value1"),
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"auto_accept",
+				},
+			},
+			{
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccVPCPeeringConnectionConfig_tags1(rName, "key1", "value1"),
+				),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_options(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+
+	testAccepterChange := func(*terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+		log.Printf("[DEBUG] Test change to the VPC Peering Connection Options.")
+
+		_, err := conn.ModifyVpcPeeringConnectionOptions(
+			&ec2.ModifyVpcPeeringConnectionOptionsInput{
+				VpcPeeringConnectionId: v.VpcPeeringConnectionId,
+				AccepterPeeringConnectionOptions: &ec2.PeeringConnectionOptionsRequest{
+					AllowDnsResolutionFromRemoteVpc: aws.Bool(false),
+				},
+			})
+
+		return err
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionConfig_accepterRequesterOptions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(
+						resourceName,
+						&v,
+					),
+					// Requester's view:
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"requester.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"requester.0.allow_remote_vpc_dns_resolution",
+						"false",
+					),
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"requester.0.allow_classic_link_to_remote_vpc",
+						"true",
+					),
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"requester.0.allow_vpc_to_remote_classic_link",
+						"true",
+					),
+					// Accepter's view:
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"accepter.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"accepter.0.allow_remote_vpc_dns_resolution",
+						"true",
+					),
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"accepter.0.allow_classic_link_to_remote_vpc",
+						"false",
+					),
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"accepter.0.allow_vpc_to_remote_classic_link",
+						"false",
+					),
+					resource.TestCheckResourceAttr(resourceName, "peering_options_managed_by", "inline"),
+					testAccepterChange,
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"auto_accept",
+					"peering_options_managed_by",
+				},
+			},
+			{
+				Config: testAccVPCPeeringConnectionConfig_accepterRequesterOptions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(
+						resourceName,
+						&v,
+					),
+					// Requester's view:
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"requester.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"requester.0.allow_remote_vpc_dns_resolution",
+						"false",
+					),
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"requester.0.allow_classic_link_to_remote_vpc",
+						"true",
+					),
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"requester.0.allow_vpc_to_remote_classic_link",
+						"true",
+					),
+					// Accepter's view:
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"accepter.#",
+						"1",
+					),
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"accepter.0.allow_remote_vpc_dns_resolution",
+						"true",
+					),
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"accepter.0.allow_classic_link_to_remote_vpc",
+						"false",
+					),
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"accepter.0.allow_vpc_to_remote_classic_link",
+						"false",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_unmanagedOptions(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionConfig_unmanagedOptions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "accept_status", "active"),
+					resource.TestCheckResourceAttr(resourceName, "manage_peering_options", "false"),
+					resource.TestCheckResourceAttr(resourceName, "requester.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "requester.0.allow_remote_vpc_dns_resolution", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_failedState(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCPeeringConnectionConfig_failedState(rName),
+				ExpectError: regexp.MustCompile(`unexpected state 'failed'`),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_selfPeering(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCPeeringConnectionConfig_selfPeering(rName),
+				ExpectError: regexp.MustCompile("`peer_vpc_id` must not be the same as `vpc_id`"),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_peerOwnerOptions(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCPeeringConnectionConfig_peerOwnerOptions(rName),
+				ExpectError: regexp.MustCompile("`requester.0.allow_remote_vpc_dns_resolution` must not be `true` for a cross-account EC2 VPC Peering Connection that has not been accepted"),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_peerOwnerInvalid(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCPeeringConnectionConfig_peerOwner(rName, "1234-5678-9012"),
+				ExpectError: regexp.MustCompile(`doesn't look like AWS Account ID`),
+			},
+			{
+				Config:      testAccVPCPeeringConnectionConfig_peerOwner(rName, "12345678901"),
+				ExpectError: regexp.MustCompile(`doesn't look like AWS Account ID`),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_peerOwnerSameAccount(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionConfig_peerOwnerSameAccount(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
+					acctest.CheckResourceAttrAccountID(resourceName, "peer_owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "peer_region", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, "accept_status", "active"),
+				),
+			},
+			{
+				Config:   testAccVPCPeeringConnectionConfig_peerOwnerSameAccount(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_peerRegionEmpty(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionConfig_peerRegion(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "peer_region", acctest.Region()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_peerRegionSameRegionAutoAccept(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionConfig_peerRegionAutoAccept(rName, acctest.Region()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "accept_status", "active"),
+					resource.TestCheckResourceAttr(resourceName, "is_cross_region", "false"),
+					resource.TestCheckResourceAttr(resourceName, "peer_region", acctest.Region()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_peerRegionInvalid(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCPeeringConnectionConfig_peerRegion(rName, "us-east1"),
+				ExpectError: regexp.MustCompile(`region name is malformed`),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_peerRegionOtherPartition(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	peerRegion := endpoints.UsGovWest1RegionID
+
+	if acctest.Partition() != endpoints.AwsPartitionID {
+		peerRegion = endpoints.UsEast1RegionID
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCPeeringConnectionConfig_peerRegion(rName, peerRegion),
+				ExpectError: regexp.MustCompile(`EC2 VPC Peering Connections cannot span partitions`),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_peerRegionAutoAccept(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionConfig_alternateRegionAutoAccept(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "accept_status", "active"),
+					resource.TestCheckResourceAttr(resourceName, "peer_region", acctest.AlternateRegion()),
+					acctest.CheckResourceAttrAccountID(resourceName, "peer_owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "is_cross_account", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_cross_region", "true"),
+					resource.TestCheckResourceAttr(resourceName, "accepter_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "requester_region", acctest.Region()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_peerRegionAutoAcceptFalse(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionConfig_alternateRegionAutoAccept(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "accept_status", "pending-acceptance"),
+					resource.TestCheckResourceAttr(resourceName, "auto_accept", "false"),
+					resource.TestCheckResourceAttr(resourceName, "peer_region", acctest.AlternateRegion()),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_accepterTags(t *testing.T) {
+	var v, vPeer ec2.VpcPeeringConnection
+	var providers []*schema.Provider
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:        acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProviderFactories: acctest.FactoriesAlternate(&providers),
+		CheckDestroy:      testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionConfig_accepterTags(rName, "Accepter"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
+					testAccCheckVPCPeeringConnectionExistsWithProvider(resourceName, &vPeer, acctest.RegionProviderFunc(acctest.AlternateRegion(), &providers)),
+					resource.TestCheckResourceAttr(resourceName, "accepter_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "accepter_tags.Side", "Accepter"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Side", "Requester"),
+				),
+			},
+			{
+				Config: testAccVPCPeeringConnectionConfig_accepterTags(rName, "Peer"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "accepter_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "accepter_tags.Side", "Peer"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Side", "Requester"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_accepterTagsSameRegion(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCPeeringConnectionConfig_accepterTagsSameRegion(rName),
+				ExpectError: regexp.MustCompile("`accepter_tags` can only be set for a cross-account or cross-region"),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_peerRegionClassicLink(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCPeeringConnectionConfig_alternateRegionClassicLink(rName),
+				ExpectError: regexp.MustCompile("`requester.0.allow_vpc_to_remote_classic_link` must not be `true` for a cross-region EC2 VPC Peering Connection"),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_peerOwnerAutoAccept(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCPeeringConnectionConfig_alternateAccountAutoAccept(rName),
+				ExpectError: regexp.MustCompile("`auto_accept` cannot be `true` for a cross-account EC2 VPC Peering Connection"),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_accepterAssumeRole(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID, iam.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionConfig_accepterAssumeRole(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "accept_status", "active"),
+					resource.TestCheckResourceAttr(resourceName, "accepter_assume_role.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "accepter_assume_role.0.role_arn", "aws_iam_role.peer", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_region(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionConfig_alternateRegionAutoAccept(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(
+						resourceName,
+						&v,
+					),
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"accept_status",
+						"pending-acceptance",
+					),
+					resource.TestCheckResourceAttrSet(resourceName, "accept_status_message"),
+				),
+			},
+		},
+	})
+}
+
+// Tests the peering connection acceptance functionality for same region, same account.
+func TestAccVPCPeeringConnection_accept(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionConfig_autoAccept(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(
+						resourceName,
+						&v,
+					),
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"accept_status",
+						"pending-acceptance",
+					),
+				),
+			},
+			{
+				Config: testAccVPCPeeringConnectionConfig_autoAccept(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(
+						resourceName,
+						&v,
+					),
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"accept_status",
+						"active",
+					),
+				),
+			},
+			// Tests that changing 'auto_accept' back to false keeps the connection active.
+			{
+				Config: testAccVPCPeeringConnectionConfig_autoAccept(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(
+						resourceName,
+						&v,
+					),
+					resource.TestCheckResourceAttr(
+						resourceName,
+						"accept_status",
+						"active",
+					),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"auto_accept",
+				},
+			},
+		},
+	})
+}
+
+// Tests that VPC peering connection options can't be set on non-active connection.
+func TestAccVPCPeeringConnection_allowRemoteVPCDNSResolution(t *testing.T) {
+	var v ec2.VpcPeeringConnection
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_peering_connection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionConfig_allowRemoteVPCDNSResolution(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "allow_remote_vpc_dns_resolution", "true"),
+					resource.TestCheckResourceAttr(resourceName, "requester.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "requester.0.allow_remote_vpc_dns_resolution", "true"),
+				),
+			},
+			{
+				// Removing the argument disables the option.
+				Config: testAccVPCPeeringConnectionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCPeeringConnectionExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "requester.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "requester.0.allow_remote_vpc_dns_resolution", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCPeeringConnection_optionsNoAutoAccept(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCPeeringConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCPeeringConnectionConfig_accepterRequesterOptionsNoAutoAccept(rName),
+				ExpectError: regexp.MustCompile(`is not active`),
+			},
+		},
+	})
+}
+
+func testAccVPCPeeringConnectionImportStateIdFunc(resourceName, orientation string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s:%s", rs.Primary.ID, orientation), nil
+	}
+}
+
+// testVPCPeeringConnectionConn returns an EC2 client whose requests are answered by the specified handler.
+func testVPCPeeringConnectionConn(t *testing.T, handler func(*request.Request)) *ec2.EC2 {
+	sess, err := session.NewSession(nil)
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	conn := ec2.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(handler)
+
+	return conn
+}
+
+// testVPCPeeringConnectionStubConn is an EC2 client whose DescribeVpcPeeringConnections responses are stubbed.
+// Calling any other EC2 API panics.
+type testVPCPeeringConnectionStubConn struct {
+	ec2iface.EC2API
+
+	describe func(*ec2.DescribeVpcPeeringConnectionsInput) (*ec2.DescribeVpcPeeringConnectionsOutput, error)
+}
+
+func (c *testVPCPeeringConnectionStubConn) DescribeVpcPeeringConnectionsPagesWithContext(_ aws.Context, input *ec2.DescribeVpcPeeringConnectionsInput, fn func(*ec2.DescribeVpcPeeringConnectionsOutput, bool) bool, _ ...request.Option) error {
+	output, err := c.describe(input)
+
+	if err != nil {
+		return err
+	}
+
+	fn(output, true)
+
+	return nil
+}
+
+// testVPCPeeringConnection returns a description of VPC Peering Connection pcx-12345678, in the specified status,
+// from requester VPC vpc-22222222 in account 111111111111 to accepter VPC vpc-11111111 in the specified account.
+// Both VPCs are in us-west-2.
+func testVPCPeeringConnection(accepterOwnerID, statusCode string, accepterOptions, requesterOptions *ec2.VpcPeeringConnectionOptionsDescription) *ec2.VpcPeeringConnection {
+	return &ec2.VpcPeeringConnection{
+		AccepterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+			OwnerId:        aws.String(accepterOwnerID),
+			PeeringOptions: accepterOptions,
+			Region:         aws.String(endpoints.UsWest2RegionID),
+			VpcId:          aws.String("vpc-11111111"),
+		},
+		RequesterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{
+			OwnerId:        aws.String("111111111111"),
+			PeeringOptions: requesterOptions,
+			Region:         aws.String(endpoints.UsWest2RegionID),
+			VpcId:          aws.String("vpc-22222222"),
+		},
+		Status:                 &ec2.VpcPeeringConnectionStateReason{Code: aws.String(statusCode)},
+		VpcPeeringConnectionId: aws.String("pcx-12345678"),
+	}
+}
+
+// testVPCPeeringConnectionOptions returns VPC Peering Connection options with only DNS resolution from the remote VPC set.
+func testVPCPeeringConnectionOptions(allowDNSResolutionFromRemoteVPC bool) *ec2.VpcPeeringConnectionOptionsDescription {
+	return &ec2.VpcPeeringConnectionOptionsDescription{AllowDnsResolutionFromRemoteVpc: aws.Bool(allowDNSResolutionFromRemoteVPC)}
+}
+
+// testVPCPeeringConnectionEndpointConn returns an EC2 client that sends requests to the specified endpoint,
+// e.g. an httptest server standing in for LocalStack or a custom EC2 endpoint.
+func testVPCPeeringConnectionEndpointConn(t *testing.T, endpoint string) *ec2.EC2 {
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKIAREQUESTER", "secret", ""),
+		Endpoint:    aws.String(endpoint),
+		MaxRetries:  aws.Int(0),
+		Region:      aws.String(endpoints.UsWest2RegionID),
+	})
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	return ec2.New(sess)
+}
+
+func testVPCPeeringConnectionEndpointDescribeResponse(statusCode string) string {
+	return fmt.Sprintf(`<DescribeVpcPeeringConnectionsResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+  <requestId>1</requestId>
+  <vpcPeeringConnectionSet>
+    <item>
+      <accepterVpcInfo><ownerId>222222222222</ownerId><region>us-west-2</region><vpcId>vpc-11111111</vpcId></accepterVpcInfo>
+      <requesterVpcInfo><ownerId>111111111111</ownerId><region>us-west-2</region><vpcId>vpc-22222222</vpcId></requesterVpcInfo>
+      <status><code>%[1]s</code></status>
+      <vpcPeeringConnectionId>pcx-12345678</vpcPeeringConnectionId>
+    </item>
+  </vpcPeeringConnectionSet>
+</DescribeVpcPeeringConnectionsResponse>`, statusCode)
+}
+
+func testAccCheckVPCPeeringConnectionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_vpc_peering_connection" {
+			continue
+		}
+
+		_, err := tfec2.FindVPCPeeringConnectionByID(context.Background(), conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("EC2 VPC Peering Connection %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckVPCPeeringConnectionExists(n string, v *ec2.VpcPeeringConnection) resource.TestCheckFunc {
+	return testAccCheckVPCPeeringConnectionExistsWithProvider(n, v, func() *schema.Provider { return acctest.Provider })
+}
+
+func testAccCheckVPCPeeringConnectionExistsWithProvider(n string, v *ec2.VpcPeeringConnection, providerF func() *schema.Provider) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No EC2 VPC Peering Connection ID is set.")
+		}
+
+		conn := providerF().Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindVPCPeeringConnectionByID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckVPCPeeringConnectionRecreated(i, j *ec2.VpcPeeringConnection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.VpcPeeringConnectionId) == aws.StringValue(j.VpcPeeringConnectionId) {
+			return errors.New("EC2 VPC Peering Connection was not recreated")
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCPeeringConnectionConfig_createBeforeDestroy(rName string, peerIndex int) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  count = 2
+
+  cidr_block = "10.${count.index + 1}.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.peer[%[2]d].id
+  auto_accept = true
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "aws_route_table" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_route" "test" {
+  route_table_id            = aws_route_table.test.id
+  destination_cidr_block    = "10.0.0.0/8"
+  vpc_peering_connection_id = aws_vpc_peering_connection.test.id
+}
+`, rName, peerIndex)
+}
+
+func testAccVPCPeeringConnectionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.peer.id
+  auto_accept = true
+}
+`, rName)
+}
+
+func testAccVPCPeeringConnectionConfig_allowRemoteVPCDNSResolution(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_hostnames = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  cidr_block           = "10.1.0.0/16"
+  enable_dns_hostnames = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.peer.id
+  auto_accept = true
+
+  allow_remote_vpc_dns_resolution = true
+}
+`, rName)
+}
+
+func testAccVPCPeeringConnectionConfig_ipv6(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block                       = "10.0.0.0/16"
+  assign_generated_ipv6_cidr_block = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.peer.id
+  auto_accept = true
+}
+`, rName)
+}
+
+func testAccVPCPeeringConnectionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  cidr_block = "10.1.0.0/16"
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.peer.id
+  auto_accept = true
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccVPCPeeringConnectionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.peer.id
+  auto_accept = true
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccVPCPeeringConnectionConfig_accepterRequesterOptions(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  cidr_block           = "10.1.0.0/16"
+  enable_dns_hostnames = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.peer.id
+  auto_accept = true
+
+  tags = {
+    Name = %[1]q
+  }
+
+  accepter {
+    allow_remote_vpc_dns_resolution = true
+  }
+
+  requester {
+    allow_vpc_to_remote_classic_link = true
+    allow_classic_link_to_remote_vpc = true
+  }
+}
+`, rName)
+}
+
+func testAccVPCPeeringConnectionConfig_failedState(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.peer.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccVPCPeeringConnectionConfig_selfPeering(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.test.id
+  auto_accept = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccVPCPeeringConnectionConfig_peerOwnerOptions(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
 }
 
-func testAccCheckVPCPeeringConnectionDestroy(s *terraform.State) error {
-	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+resource "aws_vpc" "peer" {
+  cidr_block = "10.1.0.0/16"
 
-	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "aws_vpc_peering_connection" {
-			continue
-		}
+  tags = {
+    Name = %[1]q
+  }
+}
 
-		_, err := tfec2.FindVPCPeeringConnectionByID(conn, rs.Primary.ID)
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id        = aws_vpc.test.id
+  peer_vpc_id   = aws_vpc.peer.id
+  peer_owner_id = "123456789012"
 
-		if tfresource.NotFound(err) {
-			continue
-		}
+  requester {
+    allow_remote_vpc_dns_resolution = true
+  }
 
-		if err != nil {
-			return err
-		}
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
 
-		return fmt.Errorf("EC2 VPC Peering Connection %s still exists", rs.Primary.ID)
-	}
+func testAccVPCPeeringConnectionConfig_unmanagedOptions(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block           = "10.0.0.0/16"
+  enable_dns_hostnames = true
 
-	return nil
+  tags = {
+    Name = %[1]q
+  }
 }
 
-func testAccCheckVPCPeeringConnectionExists(n string, v *ec2.VpcPeeringConnection) resource.TestCheckFunc {
-	return testAccCheckVPCPeeringConnectionExistsWithProvider(n, v, func() *schema.Provider { return acctest.Provider })
+resource "aws_vpc" "peer" {
+  cidr_block           = "10.1.0.0/16"
+  enable_dns_hostnames = true
+
+  tags = {
+    Name = %[1]q
+  }
 }
 
-func testAccCheckVPCPeeringConnectionExistsWithProvider(n string, v *ec2.VpcPeeringConnection, providerF func() *schema.Provider) resource.TestCheckFunc {
-	return func(s *terraform.State) error {
-		rs, ok := s.RootModule().Resources[n]
-		if !ok {
-			return fmt.Errorf("Not found: %s", n)
-		}
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id                 = aws_vpc.test.id
+  peer_vpc_id            = aws_vpc.peer.id
+  auto_accept            = true
+  manage_peering_options = false
 
-		if rs.Primary.ID == "" {
-			return fmt.Errorf("No EC2 VPC Peering Connection ID is set.")
-		}
+  requester {
+    allow_remote_vpc_dns_resolution = true
+  }
 
-		conn := providerF().Meta().(*conns.AWSClient).EC2Conn
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
 
-		output, err := tfec2.FindVPCPeeringConnectionByID(conn, rs.Primary.ID)
+func testAccVPCPeeringConnectionConfig_peerOwner(rName, peerOwnerID string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
 
-		if err != nil {
-			return err
-		}
+  tags = {
+    Name = %[1]q
+  }
+}
 
-		*v = *output
+resource "aws_vpc" "peer" {
+  cidr_block = "10.1.0.0/16"
 
-		return nil
-	}
+  tags = {
+    Name = %[1]q
+  }
 }
 
-func testAccVPCPeeringConnectionConfig_basic(rName string) string {
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id        = aws_vpc.test.id
+  peer_vpc_id   = aws_vpc.peer.id
+  peer_owner_id = %[2]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, peerOwnerID)
+}
+
+func testAccVPCPeeringConnectionConfig_peerOwnerSameAccount(rName string) string {
 	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
 resource "aws_vpc" "test" {
   cidr_block = "10.0.0.0/16"
 
@@ -487,14 +4607,20 @@ resource "aws_vpc" "peer" {
 }
 
 resource "aws_vpc_peering_connection" "test" {
-  vpc_id      = aws_vpc.test.id
-  peer_vpc_id = aws_vpc.peer.id
-  auto_accept = true
+  vpc_id        = aws_vpc.test.id
+  peer_vpc_id   = aws_vpc.peer.id
+  peer_owner_id = data.aws_caller_identity.current.account_id
+  peer_region   = data.aws_region.current.name
+  auto_accept   = true
+
+  tags = {
+    Name = %[1]q
+  }
 }
 `, rName)
 }
 
-func testAccVPCPeeringConnectionConfig_tags1(rName, tagKey1, tagValue1 string) string {
+func testAccVPCPeeringConnectionConfig_peerRegion(rName, peerRegion string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
   cidr_block = "10.0.0.0/16"
@@ -506,6 +4632,7 @@ resource "aws_vpc" "test" {
 
 resource "aws_vpc" "peer" {
   cidr_block = "10.1.0.0/16"
+
   tags = {
     Name = %[1]q
   }
@@ -514,16 +4641,16 @@ resource "aws_vpc" "peer" {
 resource "aws_vpc_peering_connection" "test" {
   vpc_id      = aws_vpc.test.id
   peer_vpc_id = aws_vpc.peer.id
-  auto_accept = true
+  peer_region = %[2]q
 
   tags = {
-    %[2]q = %[3]q
+    Name = %[1]q
   }
 }
-`, rName, tagKey1, tagValue1)
+`, rName, peerRegion)
 }
 
-func testAccVPCPeeringConnectionConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+func testAccVPCPeeringConnectionConfig_peerRegionAutoAccept(rName, peerRegion string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
   cidr_block = "10.0.0.0/16"
@@ -544,18 +4671,18 @@ resource "aws_vpc" "peer" {
 resource "aws_vpc_peering_connection" "test" {
   vpc_id      = aws_vpc.test.id
   peer_vpc_id = aws_vpc.peer.id
+  peer_region = %[2]q
   auto_accept = true
 
   tags = {
-    %[2]q = %[3]q
-    %[4]q = %[5]q
+    Name = %[1]q
   }
 }
-`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+`, rName, peerRegion)
 }
 
-func testAccVPCPeeringConnectionConfig_accepterRequesterOptions(rName string) string {
-	return fmt.Sprintf(`
+func testAccVPCPeeringConnectionConfig_alternateRegionAutoAccept(rName string, autoAccept bool) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
 resource "aws_vpc" "test" {
   cidr_block = "10.0.0.0/16"
 
@@ -565,8 +4692,9 @@ resource "aws_vpc" "test" {
 }
 
 resource "aws_vpc" "peer" {
-  cidr_block           = "10.1.0.0/16"
-  enable_dns_hostnames = true
+  provider = "awsalternate"
+
+  cidr_block = "10.1.0.0/16"
 
   tags = {
     Name = %[1]q
@@ -576,26 +4704,54 @@ resource "aws_vpc" "peer" {
 resource "aws_vpc_peering_connection" "test" {
   vpc_id      = aws_vpc.test.id
   peer_vpc_id = aws_vpc.peer.id
-  auto_accept = true
+  peer_region = %[3]q
+  auto_accept = %[2]t
 
   tags = {
     Name = %[1]q
   }
+}
+`, rName, autoAccept, acctest.AlternateRegion()))
+}
 
-  accepter {
-    allow_remote_vpc_dns_resolution = true
+func testAccVPCPeeringConnectionConfig_alternateRegionClassicLink(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  provider = "awsalternate"
+
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
   }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.peer.id
+  peer_region = %[2]q
 
   requester {
     allow_vpc_to_remote_classic_link = true
-    allow_classic_link_to_remote_vpc = true
+  }
+
+  tags = {
+    Name = %[1]q
   }
 }
-`, rName)
+`, rName, acctest.AlternateRegion()))
 }
 
-func testAccVPCPeeringConnectionConfig_failedState(rName string) string {
-	return fmt.Sprintf(`
+func testAccVPCPeeringConnectionConfig_alternateAccountAutoAccept(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
 resource "aws_vpc" "test" {
   cidr_block = "10.0.0.0/16"
 
@@ -605,26 +4761,38 @@ resource "aws_vpc" "test" {
 }
 
 resource "aws_vpc" "peer" {
-  cidr_block = "10.0.0.0/16"
+  provider = "awsalternate"
+
+  cidr_block = "10.1.0.0/16"
 
   tags = {
     Name = %[1]q
   }
 }
 
+data "aws_caller_identity" "peer" {
+  provider = "awsalternate"
+}
+
 resource "aws_vpc_peering_connection" "test" {
-  vpc_id      = aws_vpc.test.id
-  peer_vpc_id = aws_vpc.peer.id
+  vpc_id        = aws_vpc.test.id
+  peer_vpc_id   = aws_vpc.peer.id
+  peer_owner_id = data.aws_caller_identity.peer.account_id
+  auto_accept   = true
 
   tags = {
     Name = %[1]q
   }
 }
-`, rName)
+`, rName))
 }
 
-func testAccVPCPeeringConnectionConfig_alternateRegionAutoAccept(rName string, autoAccept bool) string {
-	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
+func testAccVPCPeeringConnectionConfig_accepterAssumeRole(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
 resource "aws_vpc" "test" {
   cidr_block = "10.0.0.0/16"
 
@@ -643,17 +4811,64 @@ resource "aws_vpc" "peer" {
   }
 }
 
+data "aws_caller_identity" "peer" {
+  provider = "awsalternate"
+}
+
+resource "aws_iam_role" "peer" {
+  provider = "awsalternate"
+
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "peer" {
+  provider = "awsalternate"
+
+  name = %[1]q
+  role = aws_iam_role.peer.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "ec2:AcceptVpcPeeringConnection",
+        "ec2:DescribeVpcPeeringConnections",
+        "ec2:ModifyVpcPeeringConnectionOptions",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
 resource "aws_vpc_peering_connection" "test" {
-  vpc_id      = aws_vpc.test.id
-  peer_vpc_id = aws_vpc.peer.id
-  peer_region = %[3]q
-  auto_accept = %[2]t
+  vpc_id        = aws_vpc.test.id
+  peer_vpc_id   = aws_vpc.peer.id
+  peer_owner_id = data.aws_caller_identity.peer.account_id
+  auto_accept   = true
+
+  accepter_assume_role {
+    role_arn = aws_iam_role.peer.arn
+  }
 
   tags = {
     Name = %[1]q
   }
+
+  depends_on = [aws_iam_role_policy.peer]
 }
-`, rName, autoAccept, acctest.AlternateRegion()))
+`, rName))
 }
 
 func testAccVPCPeeringConnectionConfig_autoAccept(rName string, autoAccept bool) string {
@@ -725,3 +4940,70 @@ resource "aws_vpc_peering_connection" "test" {
 }
 `, rName)
 }
+
+func testAccVPCPeeringConnectionConfig_accepterTags(rName, accepterSide string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  provider = "awsalternate"
+
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.peer.id
+  peer_region = %[2]q
+  auto_accept = true
+
+  tags = {
+    Side = "Requester"
+  }
+
+  accepter_tags = {
+    Side = %[3]q
+  }
+}
+`, rName, acctest.AlternateRegion(), accepterSide))
+}
+
+func testAccVPCPeeringConnectionConfig_accepterTagsSameRegion(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "peer" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test" {
+  vpc_id      = aws_vpc.test.id
+  peer_vpc_id = aws_vpc.peer.id
+  auto_accept = true
+
+  accepter_tags = {
+    Side = "Accepter"
+  }
+}
+`, rName)
+}
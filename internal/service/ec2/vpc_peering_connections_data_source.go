@@ -1,10 +1,11 @@
 package ec2
 
 import (
-	"fmt"
+	"context"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -12,7 +13,7 @@ import (
 
 func DataSourceVPCPeeringConnections() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceVPCPeeringConnectionsRead,
+		ReadWithoutTimeout: dataSourceVPCPeeringConnectionsRead,
 
 		Schema: map[string]*schema.Schema{
 			"filter": DataSourceFiltersSchema(),
@@ -26,7 +27,7 @@ func DataSourceVPCPeeringConnections() *schema.Resource {
 	}
 }
 
-func dataSourceVPCPeeringConnectionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceVPCPeeringConnectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.AWSClient).EC2Conn
 
 	input := &ec2.DescribeVpcPeeringConnectionsInput{}
@@ -41,10 +42,10 @@ func dataSourceVPCPeeringConnectionsRead(d *schema.ResourceData, meta interface{
 		input.Filters = nil
 	}
 
-	output, err := FindVPCPeeringConnections(conn, input)
+	output, err := FindVPCPeeringConnections(ctx, conn, input)
 
 	if err != nil {
-		return fmt.Errorf("error reading EC2 VPC Peering Connections: %w", err)
+		return diag.Errorf("error reading EC2 VPC Peering Connections: %s", err)
 	}
 
 	var vpcPeeringConnectionIDs []string
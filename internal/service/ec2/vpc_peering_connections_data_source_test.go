@@ -1,15 +1,65 @@
 package ec2_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 )
 
+func TestDataSourceVPCPeeringConnectionsReadTagFilters(t *testing.T) {
+	var inputs []*ec2.DescribeVpcPeeringConnectionsInput
+	conn := testVPCPeeringConnectionConn(t, func(r *request.Request) {
+		if got, want := r.Operation.Name, "DescribeVpcPeeringConnections"; got != want {
+			t.Errorf("unexpected operation: %s", got)
+		}
+
+		inputs = append(inputs, r.Params.(*ec2.DescribeVpcPeeringConnectionsInput))
+
+		r.Data.(*ec2.DescribeVpcPeeringConnectionsOutput).VpcPeeringConnections = []*ec2.VpcPeeringConnection{{
+			VpcPeeringConnectionId: aws.String("pcx-12345678"),
+		}}
+	})
+
+	ds := tfec2.DataSourceVPCPeeringConnections()
+	d := schema.TestResourceDataRaw(t, ds.Schema, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"Environment": "test",
+		},
+	})
+
+	if diags := ds.ReadWithoutTimeout(context.Background(), d, &conns.AWSClient{EC2Conn: conn, Region: endpoints.UsWest2RegionID}); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// Tags are filtered server-side, in a single request, rather than after listing every VPC Peering Connection.
+	if got, want := len(inputs), 1; got != want {
+		t.Fatalf("got %d DescribeVpcPeeringConnections calls, expected %d", got, want)
+	}
+
+	if got, want := len(inputs[0].Filters), 1; got != want {
+		t.Fatalf("got %d filters, expected %d: %s", got, want, inputs[0].Filters)
+	}
+
+	if filter := inputs[0].Filters[0]; aws.StringValue(filter.Name) != "tag:Environment" || len(filter.Values) != 1 || aws.StringValue(filter.Values[0]) != "test" {
+		t.Errorf("unexpected filter: %s", filter)
+	}
+
+	if got, want := d.Get("ids.#").(int), 1; got != want {
+		t.Errorf("got %d IDs, expected %d", got, want)
+	}
+}
+
 func TestAccVPCPeeringConnectionsDataSource_basic(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -28,6 +78,26 @@ func TestAccVPCPeeringConnectionsDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccVPCPeeringConnectionsDataSource_tagsAndFilters(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCPeeringConnectionsDataSourceConfig_tagsAndFilters(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_vpc_peering_connections.test_by_tags", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_vpc_peering_connections.test_by_requester_vpc", "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("data.aws_vpc_peering_connections.test_by_requester_vpc", "ids.*", "aws_vpc_peering_connection.test2", "id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCPeeringConnectionsDataSource_NoMatches(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -101,6 +171,76 @@ data "aws_vpc_peering_connections" "test_by_filters" {
 `, rName)
 }
 
+func testAccVPCPeeringConnectionsDataSourceConfig_tagsAndFilters(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test1" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "test2" {
+  cidr_block = "10.2.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "test3" {
+  cidr_block = "10.3.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test1" {
+  vpc_id      = aws_vpc.test1.id
+  peer_vpc_id = aws_vpc.test2.id
+  auto_accept = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_peering_connection" "test2" {
+  vpc_id      = aws_vpc.test2.id
+  peer_vpc_id = aws_vpc.test3.id
+  auto_accept = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_vpc_peering_connections" "test_by_tags" {
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_vpc_peering_connection.test1, aws_vpc_peering_connection.test2]
+}
+
+data "aws_vpc_peering_connections" "test_by_requester_vpc" {
+  filter {
+    name   = "requester-vpc-info.vpc-id"
+    values = [aws_vpc.test2.id]
+  }
+
+  filter {
+    name   = "status-code"
+    values = ["active"]
+  }
+
+  depends_on = [aws_vpc_peering_connection.test1, aws_vpc_peering_connection.test2]
+}
+`, rName)
+}
+
 func testAccVPCPeeringConnectionsDataSourceConfig_noMatches(rName string) string {
 	return fmt.Sprintf(`
 data "aws_vpc_peering_connections" "test" {
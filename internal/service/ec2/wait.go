@@ -1,13 +1,17 @@
 package ec2
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"log"
 	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -1595,18 +1599,48 @@ func WaitVPCIPv6CIDRBlockAssociationDeleted(conn *ec2.EC2, id string, timeout ti
 }
 
 const (
-	VPCPeeringConnectionOptionsPropagationTimeout = 3 * time.Minute
+	VPCPeeringConnectionCrossRegionCreateTimeout = 10 * time.Minute
+
+	vpcPeeringConnectionActiveDelay      = 10 * time.Second
+	vpcPeeringConnectionActiveMinTimeout = 5 * time.Second
+
+	// vpcPeeringConnectionSlowWaitThreshold is the duration after which a VPC Peering Connection wait is logged as slow.
+	vpcPeeringConnectionSlowWaitThreshold = 30 * time.Second
 )
 
-func WaitVPCPeeringConnectionActive(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VpcPeeringConnection, error) {
+// waitVPCPeeringConnection waits for the specified VPC Peering Connection state change,
+// logging the elapsed time and number of polls if the wait is slow.
+func waitVPCPeeringConnection(ctx context.Context, stateConf *resource.StateChangeConf, id, operation string) (interface{}, error) {
+	var polls int32
+	refresh := stateConf.Refresh
+	stateConf.Refresh = func() (interface{}, string, error) {
+		atomic.AddInt32(&polls, 1)
+
+		return refresh()
+	}
+
+	start := time.Now()
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if elapsed := time.Since(start); elapsed >= vpcPeeringConnectionSlowWaitThreshold {
+		log.Printf("[INFO] Slow EC2 VPC Peering Connection wait: %s (%s) took %s (%d polls)", operation, id, elapsed.Round(time.Second), atomic.LoadInt32(&polls))
+	}
+
+	return outputRaw, err
+}
+
+func WaitVPCPeeringConnectionActive(ctx context.Context, conn ec2iface.EC2API, id string, timeout time.Duration) (*ec2.VpcPeeringConnection, error) {
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{ec2.VpcPeeringConnectionStateReasonCodeInitiatingRequest, ec2.VpcPeeringConnectionStateReasonCodeProvisioning},
-		Target:  []string{ec2.VpcPeeringConnectionStateReasonCodeActive, ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance},
-		Refresh: StatusVPCPeeringConnectionActive(conn, id),
-		Timeout: timeout,
+		// An empty status code is returned while a new VPC Peering Connection is not yet fully visible.
+		Pending:    []string{"", ec2.VpcPeeringConnectionStateReasonCodeInitiatingRequest, ec2.VpcPeeringConnectionStateReasonCodeProvisioning},
+		Target:     []string{ec2.VpcPeeringConnectionStateReasonCodeActive, ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance},
+		Refresh:    StatusVPCPeeringConnectionActive(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      vpcPeeringConnectionActiveDelay,
+		MinTimeout: vpcPeeringConnectionActiveMinTimeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := waitVPCPeeringConnection(ctx, stateConf, id, "active")
 
 	if output, ok := outputRaw.(*ec2.VpcPeeringConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
@@ -1617,19 +1651,49 @@ func WaitVPCPeeringConnectionActive(conn *ec2.EC2, id string, timeout time.Durat
 	return nil, err
 }
 
-func WaitVPCPeeringConnectionDeleted(conn *ec2.EC2, id string, timeout time.Duration) (*ec2.VpcPeeringConnection, error) {
+func WaitVPCPeeringConnectionAccepted(ctx context.Context, conn ec2iface.EC2API, id string, timeout time.Duration) (*ec2.VpcPeeringConnection, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
+			"",
+			ec2.VpcPeeringConnectionStateReasonCodeInitiatingRequest,
+			ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance,
+			ec2.VpcPeeringConnectionStateReasonCodeProvisioning,
+		},
+		Target:     []string{ec2.VpcPeeringConnectionStateReasonCodeActive},
+		Refresh:    StatusVPCPeeringConnectionActive(ctx, conn, id),
+		Timeout:    timeout,
+		Delay:      vpcPeeringConnectionActiveDelay,
+		MinTimeout: vpcPeeringConnectionActiveMinTimeout,
+	}
+
+	outputRaw, err := waitVPCPeeringConnection(ctx, stateConf, id, "accepted")
+
+	if output, ok := outputRaw.(*ec2.VpcPeeringConnection); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// WaitVPCPeeringConnectionDeleted waits for the specified VPC Peering Connection to be deleted.
+// A VPC Peering Connection that is no longer found, rather than described as deleted, is also deleted.
+// Polling backs off exponentially as no PollInterval is set.
+func WaitVPCPeeringConnectionDeleted(ctx context.Context, conn ec2iface.EC2API, id string, timeout time.Duration) (*ec2.VpcPeeringConnection, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			"",
 			ec2.VpcPeeringConnectionStateReasonCodeActive,
 			ec2.VpcPeeringConnectionStateReasonCodeDeleting,
 			ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance,
 		},
 		Target:  []string{},
-		Refresh: StatusVPCPeeringConnectionDeleted(conn, id),
+		Refresh: StatusVPCPeeringConnectionDeleted(ctx, conn, id),
 		Timeout: timeout,
 	}
 
-	outputRaw, err := stateConf.WaitForState()
+	outputRaw, err := waitVPCPeeringConnection(ctx, stateConf, id, "deleted")
 
 	if output, ok := outputRaw.(*ec2.VpcPeeringConnection); ok {
 		tfresource.SetLastError(err, errors.New(aws.StringValue(output.Status.Message)))